@@ -0,0 +1,274 @@
+// Package graphql serves the events, products and flowers of the ServiceCore as one GraphQL schema, so
+// a client reads an event with its products, flowers and packing options in a single request. The
+// relations are loaded through per request dataloaders, one query per level of the tree.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+	"strings"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// maxDepth bounds how deep a query nests, the deepest tree of the schema is an event page down to the
+// packing options of its flowers
+const maxDepth = 10
+
+// NewSchema parses the schema, the returned schema is shared by every request
+func NewSchema() *gqlgo.Schema {
+	return gqlgo.MustParseSchema(schema, &Resolver{}, gqlgo.MaxDepth(maxDepth))
+}
+
+type requestKey struct{}
+
+// NewContext returns the context to execute a request of the service with, the service decides which
+// tenant the request sees and what it may read
+func NewContext(ctx context.Context, service *servicecore.ServiceCore) context.Context {
+	return context.WithValue(ctx, requestKey{}, newLoaders(service))
+}
+
+func requestLoaders(ctx context.Context) *loaders {
+	return ctx.Value(requestKey{}).(*loaders)
+}
+
+// Resolver resolves the fields of the query type
+type Resolver struct{}
+
+type pageArgs struct {
+	IncludeDeleted *bool
+	First          *int32
+	After          *string
+	Sort           *string
+}
+
+type eventsArgs struct {
+	IDs         *[]gqlgo.ID
+	Name        *string
+	Statuses    *[]string
+	From        *gqlgo.Time
+	To          *gqlgo.Time
+	CustomerIDs *[]gqlgo.ID
+	pageArgs
+}
+
+type catalogArgs struct {
+	IDs  *[]gqlgo.ID
+	Name *string
+	pageArgs
+}
+
+func (r *Resolver) Event(ctx context.Context, args struct{ ID gqlgo.ID }) (*eventResolver, error) {
+	event, err := requestLoaders(ctx).service.GetEvent(string(args.ID))
+	if err != nil || event == nil {
+		return nil, notFoundAsNull(err)
+	}
+	return &eventResolver{event: event}, nil
+}
+
+func (r *Resolver) Events(ctx context.Context, args eventsArgs) (*eventPageResolver, error) {
+	getFilteredEventsRequest := &contracts.GetFilteredEventsRequest{
+		IDs:            ids(args.IDs),
+		Name:           value(args.Name),
+		CustomerIDs:    ids(args.CustomerIDs),
+		IncludeDeleted: value(args.IncludeDeleted),
+		Page:           args.page(),
+	}
+	if args.Statuses != nil {
+		for _, status := range *args.Statuses {
+			getFilteredEventsRequest.Statuses = append(getFilteredEventsRequest.Statuses, contracts.EventStatus(fromEnum(status)))
+		}
+	}
+	if args.From != nil {
+		getFilteredEventsRequest.DateFrom = args.From.Time
+	}
+	if args.To != nil {
+		getFilteredEventsRequest.DateTo = args.To.Time
+	}
+
+	events, page, err := requestLoaders(ctx).service.GetFilteredEvents(getFilteredEventsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventPageResolver{events: events, page: page}, nil
+}
+
+func (r *Resolver) Product(ctx context.Context, args struct{ ID gqlgo.ID }) (*productResolver, error) {
+	product, err := requestLoaders(ctx).products.Load(ctx, string(args.ID))()
+	if err != nil || product == nil {
+		return nil, notFoundAsNull(err)
+	}
+	return &productResolver{product: product}, nil
+}
+
+func (r *Resolver) Products(ctx context.Context, args catalogArgs) (*productPageResolver, error) {
+	products, page, err := requestLoaders(ctx).service.GetFilteredProducts(&contracts.GetFilteredProductsRequest{
+		IDs:            ids(args.IDs),
+		Name:           value(args.Name),
+		IncludeDeleted: value(args.IncludeDeleted),
+		Page:           args.page(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &productPageResolver{products: products, page: page}, nil
+}
+
+func (r *Resolver) Flower(ctx context.Context, args struct{ ID gqlgo.ID }) (*flowerResolver, error) {
+	flower, err := requestLoaders(ctx).flowers.Load(ctx, string(args.ID))()
+	if err != nil || flower == nil {
+		return nil, notFoundAsNull(err)
+	}
+	return &flowerResolver{flower: flower}, nil
+}
+
+func (r *Resolver) Flowers(ctx context.Context, args catalogArgs) (*flowerPageResolver, error) {
+	flowers, page, err := requestLoaders(ctx).service.GetFilteredFlowers(&contracts.GetFilteredFlowersRequest{
+		IDs:            ids(args.IDs),
+		Name:           value(args.Name),
+		IncludeDeleted: value(args.IncludeDeleted),
+		Page:           args.page(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &flowerPageResolver{flowers: flowers, page: page}, nil
+}
+
+// page converts the page arguments, sort takes the fields of the sort query parameter of the REST API
+func (args pageArgs) page() contracts.PageRequest {
+	page := contracts.PageRequest{
+		Limit:  int(value(args.First)),
+		Cursor: value(args.After),
+	}
+	for _, field := range strings.Split(value(args.Sort), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		page.Sort = append(page.Sort, contracts.SortField{
+			Field:      strings.TrimPrefix(field, "-"),
+			Descending: strings.HasPrefix(field, "-"),
+		})
+	}
+
+	return page
+}
+
+type eventPageResolver struct {
+	events []*persistency.Event
+	page   *persistency.Page
+}
+
+func (r *eventPageResolver) Nodes() []*eventResolver {
+	nodes := make([]*eventResolver, len(r.events))
+	for i, event := range r.events {
+		nodes[i] = &eventResolver{event: event}
+	}
+	return nodes
+}
+
+func (r *eventPageResolver) Total() int32 {
+	return int32(r.page.Total)
+}
+
+func (r *eventPageResolver) NextCursor() *string {
+	return nextCursor(r.page)
+}
+
+type productPageResolver struct {
+	products []*persistency.Product
+	page     *persistency.Page
+}
+
+func (r *productPageResolver) Nodes() []*productResolver {
+	nodes := make([]*productResolver, len(r.products))
+	for i, product := range r.products {
+		nodes[i] = &productResolver{product: product}
+	}
+	return nodes
+}
+
+func (r *productPageResolver) Total() int32 {
+	return int32(r.page.Total)
+}
+
+func (r *productPageResolver) NextCursor() *string {
+	return nextCursor(r.page)
+}
+
+type flowerPageResolver struct {
+	flowers []*persistency.Flower
+	page    *persistency.Page
+}
+
+func (r *flowerPageResolver) Nodes() []*flowerResolver {
+	nodes := make([]*flowerResolver, len(r.flowers))
+	for i, flower := range r.flowers {
+		nodes[i] = &flowerResolver{flower: flower}
+	}
+	return nodes
+}
+
+func (r *flowerPageResolver) Total() int32 {
+	return int32(r.page.Total)
+}
+
+func (r *flowerPageResolver) NextCursor() *string {
+	return nextCursor(r.page)
+}
+
+// notFoundAsNull answers a missing row with null rather than an error, like GraphQL APIs usually do
+func notFoundAsNull(err error) error {
+	if errors.Is(err, persistency.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func nextCursor(page *persistency.Page) *string {
+	if page.NextCursor == "" {
+		return nil
+	}
+	return &page.NextCursor
+}
+
+// toEnum and fromEnum convert the values of the string enums of the service, as in "in-production",
+// from and to the values of the GraphQL enums, as in IN_PRODUCTION
+func toEnum(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+}
+
+func fromEnum(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", "-"))
+}
+
+func ids(values *[]gqlgo.ID) []string {
+	if values == nil {
+		return nil
+	}
+	strs := make([]string, len(*values))
+	for i, id := range *values {
+		strs[i] = string(id)
+	}
+	return strs
+}
+
+// value dereferences an optional argument, unset arguments take the zero value
+func value[T any](arg *T) T {
+	if arg == nil {
+		var zero T
+		return zero
+	}
+	return *arg
+}
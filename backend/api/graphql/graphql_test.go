@@ -0,0 +1,149 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore/servicecoretest"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+// countingDal counts the reads of the relations and of the catalog
+type countingDal struct {
+	persistency.DalInterface
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (d *countingDal) count(method string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls[method]++
+}
+
+func (d *countingDal) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, *persistency.Page, error) {
+	d.count("GetFilteredFlowers")
+	return d.DalInterface.GetFilteredFlowers(req)
+}
+
+func (d *countingDal) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, *persistency.Page, error) {
+	d.count("GetFilteredProducts")
+	return d.DalInterface.GetFilteredProducts(req)
+}
+
+func (d *countingDal) GetProductsFromEvents(eventIDs []string) ([]*persistency.EventProduct, error) {
+	d.count("GetProductsFromEvents")
+	return d.DalInterface.GetProductsFromEvents(eventIDs)
+}
+
+func (d *countingDal) GetFlowersFromProducts(productIDs []string) ([]*persistency.FlowerInProduct, error) {
+	d.count("GetFlowersFromProducts")
+	return d.DalInterface.GetFlowersFromProducts(productIDs)
+}
+
+func (d *countingDal) GetFlowersPackingOptions(flowerIDs []string) ([]*persistency.FlowerPackageOptions, error) {
+	d.count("GetFlowersPackingOptions")
+	return d.DalInterface.GetFlowersPackingOptions(flowerIDs)
+}
+
+func TestEventPageIsLoadedOneLevelAtATime(t *testing.T) {
+	dal := &countingDal{DalInterface: mock.NewDalMock(), calls: map[string]int{}}
+	service := servicecoretest.New(dal)
+
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{{Quantity: 10, Price: 5}})
+	dal.CreateFlower(&persistency.Flower{ID: "lily", Name: "Lily"}, &[]contracts.PackingOptions{{Quantity: 5, Price: 4}})
+	dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bouquet"})
+	dal.CreateProduct(&persistency.Product{ID: "garland", Name: "Garland"})
+	dal.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: "bouquet", Flowers: &[]contracts.FlowerInProduct{
+		{FlowerID: "rose", NumOfFlowers: 12},
+		{FlowerID: "lily", NumOfFlowers: 3},
+	}})
+	dal.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: "garland", Flowers: &[]contracts.FlowerInProduct{
+		{FlowerID: "lily", NumOfFlowers: 20},
+	}})
+	for _, id := range []string{"wedding", "gala"} {
+		dal.CreateEvent(&persistency.Event{ID: id, Name: id, Status: contracts.EventStatusInquiry})
+		dal.AddProductsToEvent(&contracts.AddProductsToEventRequest{EventID: id, Products: &[]contracts.ProductInEvent{
+			{ProductID: "bouquet", Quantity: 2},
+			{ProductID: "garland", Quantity: 1},
+		}})
+	}
+
+	response := NewSchema().Exec(NewContext(context.Background(), service), `{
+		events(sort: "name") {
+			nodes {
+				name
+				status
+				products {
+					quantity
+					product {
+						name
+						flowers { numOfFlowers flower { name packingOptions { quantity price } } }
+					}
+				}
+			}
+		}
+	}`, "", nil)
+	if len(response.Errors) > 0 {
+		t.Fatal(response.Errors)
+	}
+
+	var data struct {
+		Events struct {
+			Nodes []struct {
+				Name     string
+				Status   string
+				Products []struct {
+					Quantity int
+					Product  struct {
+						Name    string
+						Flowers []struct {
+							NumOfFlowers int
+							Flower       struct {
+								Name           string
+								PackingOptions []struct{ Quantity int }
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+
+	events := data.Events.Nodes
+	if len(events) != 2 || events[0].Name != "gala" || events[0].Status != "INQUIRY" {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	bouquet := events[1].Products[0].Product
+	if bouquet.Name != "Bouquet" || len(bouquet.Flowers) != 2 || bouquet.Flowers[0].Flower.Name != "Rose" {
+		t.Errorf("unexpected product %+v", bouquet)
+	}
+	if options := bouquet.Flowers[1].Flower.PackingOptions; len(options) != 1 || options[0].Quantity != 5 {
+		t.Errorf("unexpected packing options of the lily %+v", options)
+	}
+
+	for _, method := range []string{"GetProductsFromEvents", "GetFilteredProducts", "GetFlowersFromProducts", "GetFilteredFlowers", "GetFlowersPackingOptions"} {
+		if dal.calls[method] != 1 {
+			t.Errorf("%s was called %d times, want a single batch", method, dal.calls[method])
+		}
+	}
+}
+
+func TestMissingEventIsNull(t *testing.T) {
+	service := servicecoretest.New(mock.NewDalMock())
+
+	response := NewSchema().Exec(NewContext(context.Background(), service), `{ event(id: "gala") { name } }`, "", nil)
+	if len(response.Errors) > 0 {
+		t.Fatal(response.Errors)
+	}
+	if string(response.Data) != `{"event":null}` {
+		t.Errorf("a missing event should be null, got %s", response.Data)
+	}
+}
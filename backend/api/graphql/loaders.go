@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"context"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// maxBatchSize keeps the rows loaded by one batch within the largest page the service returns
+const maxBatchSize = 500
+
+// loaders collect the rows the resolvers of one request ask for and load them a batch at a time, so
+// the products of every event of a page cost one query instead of one per event
+type loaders struct {
+	service        *servicecore.ServiceCore
+	flowers        *dataloader.Loader[string, *persistency.Flower]
+	products       *dataloader.Loader[string, *persistency.Product]
+	eventProducts  *dataloader.Loader[string, []*persistency.EventProduct]
+	productFlowers *dataloader.Loader[string, []*persistency.FlowerInProduct]
+	packingOptions *dataloader.Loader[string, []*persistency.FlowerPackageOptions]
+}
+
+func newLoaders(service *servicecore.ServiceCore) *loaders {
+	l := &loaders{service: service}
+	l.flowers = dataloader.NewBatchedLoader(l.loadFlowers, dataloader.WithBatchCapacity[string, *persistency.Flower](maxBatchSize))
+	l.products = dataloader.NewBatchedLoader(l.loadProducts, dataloader.WithBatchCapacity[string, *persistency.Product](maxBatchSize))
+	l.eventProducts = dataloader.NewBatchedLoader(l.loadEventProducts)
+	l.productFlowers = dataloader.NewBatchedLoader(l.loadProductFlowers)
+	l.packingOptions = dataloader.NewBatchedLoader(l.loadPackingOptions)
+	return l
+}
+
+// loadFlowers includes the deleted flowers, a product still shows the flowers it was made of
+func (l *loaders) loadFlowers(_ context.Context, ids []string) []*dataloader.Result[*persistency.Flower] {
+	flowers, _, err := l.service.GetFilteredFlowers(&contracts.GetFilteredFlowersRequest{
+		IDs:            ids,
+		IncludeDeleted: true,
+		Page:           contracts.PageRequest{Limit: len(ids)},
+	})
+
+	flowersByID := make(map[string]*persistency.Flower, len(flowers))
+	for _, flower := range flowers {
+		flowersByID[flower.ID] = flower
+	}
+	return results(ids, flowersByID, err)
+}
+
+// loadProducts includes the deleted products, an event still shows the products it was ordered with
+func (l *loaders) loadProducts(_ context.Context, ids []string) []*dataloader.Result[*persistency.Product] {
+	products, _, err := l.service.GetFilteredProducts(&contracts.GetFilteredProductsRequest{
+		IDs:            ids,
+		IncludeDeleted: true,
+		Page:           contracts.PageRequest{Limit: len(ids)},
+	})
+
+	productsByID := make(map[string]*persistency.Product, len(products))
+	for _, product := range products {
+		productsByID[product.ID] = product
+	}
+	return results(ids, productsByID, err)
+}
+
+func (l *loaders) loadEventProducts(_ context.Context, eventIDs []string) []*dataloader.Result[[]*persistency.EventProduct] {
+	eventProducts, err := l.service.GetProductsFromEvents(eventIDs)
+
+	productsByEvent := make(map[string][]*persistency.EventProduct, len(eventIDs))
+	for _, eventProduct := range eventProducts {
+		productsByEvent[eventProduct.EventID] = append(productsByEvent[eventProduct.EventID], eventProduct)
+	}
+	return results(eventIDs, productsByEvent, err)
+}
+
+func (l *loaders) loadProductFlowers(_ context.Context, productIDs []string) []*dataloader.Result[[]*persistency.FlowerInProduct] {
+	productFlowers, err := l.service.GetFlowersFromProducts(productIDs)
+
+	flowersByProduct := make(map[string][]*persistency.FlowerInProduct, len(productIDs))
+	for _, productFlower := range productFlowers {
+		flowersByProduct[productFlower.ProductID] = append(flowersByProduct[productFlower.ProductID], productFlower)
+	}
+	return results(productIDs, flowersByProduct, err)
+}
+
+func (l *loaders) loadPackingOptions(_ context.Context, flowerIDs []string) []*dataloader.Result[[]*persistency.FlowerPackageOptions] {
+	packingOptions, err := l.service.GetFlowersPackingOptions(flowerIDs)
+
+	optionsByFlower := make(map[string][]*persistency.FlowerPackageOptions, len(flowerIDs))
+	for _, packingOption := range packingOptions {
+		optionsByFlower[packingOption.FlowerID] = append(optionsByFlower[packingOption.FlowerID], packingOption)
+	}
+	return results(flowerIDs, optionsByFlower, err)
+}
+
+// results answers the keys of a batch in their order, a failed batch fails every key
+func results[V any](keys []string, values map[string]V, err error) []*dataloader.Result[V] {
+	batch := make([]*dataloader.Result[V], len(keys))
+	for i, key := range keys {
+		if err != nil {
+			batch[i] = &dataloader.Result[V]{Error: err}
+			continue
+		}
+		batch[i] = &dataloader.Result[V]{Data: values[key]}
+	}
+	return batch
+}
@@ -0,0 +1,304 @@
+package graphql
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"time"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+)
+
+type eventResolver struct {
+	event *persistency.Event
+}
+
+func (r *eventResolver) ID() gqlgo.ID {
+	return gqlgo.ID(r.event.ID)
+}
+
+func (r *eventResolver) Name() string {
+	return r.event.Name
+}
+
+func (r *eventResolver) Date() gqlgo.Time {
+	return gqlgo.Time{Time: r.event.Date}
+}
+
+func (r *eventResolver) Status() string {
+	return toEnum(string(r.event.Status))
+}
+
+func (r *eventResolver) CustomerID() *gqlgo.ID {
+	if r.event.CustomerID == "" {
+		return nil
+	}
+	customerID := gqlgo.ID(r.event.CustomerID)
+	return &customerID
+}
+
+func (r *eventResolver) Phone() string {
+	return r.event.Phone
+}
+
+func (r *eventResolver) Email() string {
+	return r.event.Email
+}
+
+func (r *eventResolver) Address() string {
+	return r.event.Address
+}
+
+func (r *eventResolver) Description() string {
+	return r.event.Description
+}
+
+func (r *eventResolver) DeletedAt() *gqlgo.Time {
+	return optionalTime(r.event.DeletedAt)
+}
+
+func (r *eventResolver) Products(ctx context.Context) ([]*eventProductResolver, error) {
+	eventProducts, err := requestLoaders(ctx).eventProducts.Load(ctx, r.event.ID)()
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*eventProductResolver, len(eventProducts))
+	for i, eventProduct := range eventProducts {
+		products[i] = &eventProductResolver{eventProduct: eventProduct}
+	}
+	return products, nil
+}
+
+type flowerRequirementsArgs struct {
+	Strategy        *string
+	MaxWastePercent *float64
+	Sourcing        *string
+}
+
+func (r *eventResolver) FlowerRequirements(ctx context.Context, args flowerRequirementsArgs) (*flowerRequirementsResolver, error) {
+	if value(args.MaxWastePercent) < 0 {
+		return nil, persistency.InvalidField("maxWastePercent", "max waste percent must not be negative")
+	}
+
+	flowers, err := requestLoaders(ctx).service.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{
+		EventID:         r.event.ID,
+		Strategy:        contracts.PackingStrategy(fromEnum(value(args.Strategy))),
+		MaxWastePercent: value(args.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(fromEnum(value(args.Sourcing))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &flowerRequirementsResolver{flowers: flowers}, nil
+}
+
+type eventProductResolver struct {
+	eventProduct *persistency.EventProduct
+}
+
+func (r *eventProductResolver) Product(ctx context.Context) (*productResolver, error) {
+	product, err := requestLoaders(ctx).products.Load(ctx, r.eventProduct.ProductID)()
+	if err != nil || product == nil {
+		return nil, err
+	}
+	return &productResolver{product: product}, nil
+}
+
+func (r *eventProductResolver) Quantity() int32 {
+	return int32(r.eventProduct.Quantity)
+}
+
+type productResolver struct {
+	product *persistency.Product
+}
+
+func (r *productResolver) ID() gqlgo.ID {
+	return gqlgo.ID(r.product.ID)
+}
+
+func (r *productResolver) Name() string {
+	return r.product.Name
+}
+
+func (r *productResolver) Description() string {
+	return r.product.Description
+}
+
+func (r *productResolver) DeletedAt() *gqlgo.Time {
+	return optionalTime(r.product.DeletedAt)
+}
+
+func (r *productResolver) Flowers(ctx context.Context) ([]*productFlowerResolver, error) {
+	productFlowers, err := requestLoaders(ctx).productFlowers.Load(ctx, r.product.ID)()
+	if err != nil {
+		return nil, err
+	}
+
+	flowers := make([]*productFlowerResolver, len(productFlowers))
+	for i, productFlower := range productFlowers {
+		flowers[i] = &productFlowerResolver{productFlower: productFlower}
+	}
+	return flowers, nil
+}
+
+type productFlowerResolver struct {
+	productFlower *persistency.FlowerInProduct
+}
+
+func (r *productFlowerResolver) Flower(ctx context.Context) (*flowerResolver, error) {
+	return loadFlower(ctx, r.productFlower.FlowerID)
+}
+
+func (r *productFlowerResolver) NumOfFlowers() int32 {
+	return int32(r.productFlower.NumOfFlowers)
+}
+
+type flowerResolver struct {
+	flower *persistency.Flower
+}
+
+func (r *flowerResolver) ID() gqlgo.ID {
+	return gqlgo.ID(r.flower.ID)
+}
+
+func (r *flowerResolver) Name() string {
+	return r.flower.Name
+}
+
+func (r *flowerResolver) ShelfLifeDays() int32 {
+	return int32(r.flower.ShelfLifeDays)
+}
+
+func (r *flowerResolver) DeletedAt() *gqlgo.Time {
+	return optionalTime(r.flower.DeletedAt)
+}
+
+func (r *flowerResolver) PackingOptions(ctx context.Context) ([]*packingOptionResolver, error) {
+	packingOptions, err := requestLoaders(ctx).packingOptions.Load(ctx, r.flower.ID)()
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*packingOptionResolver, len(packingOptions))
+	for i, packingOption := range packingOptions {
+		options[i] = &packingOptionResolver{packingOption: packingOption}
+	}
+	return options, nil
+}
+
+type packingOptionResolver struct {
+	packingOption *persistency.FlowerPackageOptions
+}
+
+func (r *packingOptionResolver) SupplierID() gqlgo.ID {
+	return gqlgo.ID(r.packingOption.SupplierID)
+}
+
+func (r *packingOptionResolver) Quantity() int32 {
+	return int32(r.packingOption.NumOfFlowers)
+}
+
+func (r *packingOptionResolver) Price() float64 {
+	return r.packingOption.Price
+}
+
+type flowerRequirementsResolver struct {
+	flowers *contracts.FlowersPackagesResponse
+}
+
+func (r *flowerRequirementsResolver) Strategy() string {
+	return toEnum(string(r.flowers.Strategy))
+}
+
+func (r *flowerRequirementsResolver) MaxWastePercent() float64 {
+	return r.flowers.MaxWastePercent
+}
+
+func (r *flowerRequirementsResolver) Sourcing() string {
+	return toEnum(string(r.flowers.Sourcing))
+}
+
+func (r *flowerRequirementsResolver) TotalPrice() float64 {
+	return r.flowers.TotalPrice
+}
+
+func (r *flowerRequirementsResolver) TotalPackages() int32 {
+	return int32(r.flowers.TotalPackages)
+}
+
+func (r *flowerRequirementsResolver) TotalFlowersRequired() int32 {
+	return int32(r.flowers.TotalFlowersRequired)
+}
+
+func (r *flowerRequirementsResolver) TotalFlowersFromStock() int32 {
+	return int32(r.flowers.TotalFlowersFromStock)
+}
+
+func (r *flowerRequirementsResolver) TotalFlowersOverbought() int32 {
+	return int32(r.flowers.TotalFlowersOverbought)
+}
+
+func (r *flowerRequirementsResolver) Packages() []*flowerPackagesResolver {
+	packages := make([]*flowerPackagesResolver, len(r.flowers.Packages))
+	for i, flowerPackages := range r.flowers.Packages {
+		packages[i] = &flowerPackagesResolver{packages: flowerPackages}
+	}
+	return packages
+}
+
+type flowerPackagesResolver struct {
+	packages *contracts.FlowerPackages
+}
+
+func (r *flowerPackagesResolver) Flower(ctx context.Context) (*flowerResolver, error) {
+	return loadFlower(ctx, r.packages.FlowerID)
+}
+
+func (r *flowerPackagesResolver) SupplierID() gqlgo.ID {
+	return gqlgo.ID(r.packages.SupplierID)
+}
+
+func (r *flowerPackagesResolver) SupplierName() string {
+	return r.packages.SupplierName
+}
+
+func (r *flowerPackagesResolver) NumOfFlowersInPackage() int32 {
+	return int32(r.packages.NumOfFlowersInPackage)
+}
+
+func (r *flowerPackagesResolver) NumOfPackages() int32 {
+	return int32(r.packages.NumOfPackages)
+}
+
+func (r *flowerPackagesResolver) Price() float64 {
+	return r.packages.Price
+}
+
+func (r *flowerPackagesResolver) NumOfFlowersRequired() int32 {
+	return int32(r.packages.NumOfFlowersRequired)
+}
+
+func (r *flowerPackagesResolver) NumOfFlowersFromStock() int32 {
+	return int32(r.packages.NumOfFlowersFromStock)
+}
+
+func (r *flowerPackagesResolver) NumOfFlowersOverbought() int32 {
+	return int32(r.packages.NumOfFlowersOverbought)
+}
+
+func loadFlower(ctx context.Context, id string) (*flowerResolver, error) {
+	flower, err := requestLoaders(ctx).flowers.Load(ctx, id)()
+	if err != nil || flower == nil {
+		return nil, err
+	}
+	return &flowerResolver{flower: flower}, nil
+}
+
+func optionalTime(t *time.Time) *gqlgo.Time {
+	if t == nil {
+		return nil
+	}
+	return &gqlgo.Time{Time: *t}
+}
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// actorHeader names who makes the request while authentication is disabled, it is recorded in the
+// audit log with every change
+const actorHeader = "X-Actor"
+
+// requestService returns the service acting on behalf of the authenticated user of the request, in the
+// tenant of the user. While authentication is disabled the tenant is taken from the tenant header, the
+// default tenant when there is none.
+func requestService(c *fiber.Ctx, service *servicecore.ServiceCore) *servicecore.ServiceCore {
+	if user := currentUser(c); user != nil {
+		return service.WithUser(user)
+	}
+	if !service.AuthConfig.Enabled {
+		return service.WithActor(c.Get(actorHeader)).WithTenant(c.Get(tenantHeader, persistency.DefaultTenantID))
+	}
+	return service
+}
+
+func getAuditRecords(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getAuditRecordsPayload payloads.GetAuditRecordsPayload
+
+	if err := c.QueryParser(&getAuditRecordsPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getAuditRecordsPayload); err != nil {
+		return err
+	}
+
+	getAuditRecordsRequest := &contracts.GetAuditRecordsRequest{
+		EntityType: contracts.AuditEntityType(getAuditRecordsPayload.EntityType),
+		EntityID:   getAuditRecordsPayload.EntityID,
+	}
+
+	records, err := service.GetAuditRecords(getAuditRecordsRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(records)
+}
@@ -0,0 +1,207 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// userLocal holds the authenticated user of a request
+const userLocal = "user"
+
+// authenticate rejects requests without a valid login or API token in the Authorization header
+func authenticate(service *servicecore.ServiceCore) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token, found := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+		if !found || token == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, servicecore.ErrUnauthenticated.Error())
+		}
+
+		user, err := service.Authenticate(token)
+		if err != nil {
+			return err
+		}
+
+		c.Locals(userLocal, user)
+		return c.Next()
+	}
+}
+
+// currentUser returns the authenticated user of the request, nil when authentication is disabled
+func currentUser(c *fiber.Ctx) *persistency.User {
+	user, _ := c.Locals(userLocal).(*persistency.User)
+	return user
+}
+
+func login(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var loginPayload payloads.LoginPayload
+
+	if err := c.BodyParser(&loginPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(loginPayload); err != nil {
+		return err
+	}
+
+	loginRequest := &contracts.LoginRequest{
+		Username: loginPayload.Username,
+		Password: loginPayload.Password,
+	}
+
+	response, err := service.Login(loginRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(response)
+}
+
+func createUser(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var createUserPayload payloads.CreateUserPayload
+
+	if err := c.BodyParser(&createUserPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(createUserPayload); err != nil {
+		return err
+	}
+
+	createUserRequest := &contracts.CreateUserRequest{
+		Username: createUserPayload.Username,
+		Password: createUserPayload.Password,
+		Role:     contracts.Role(createUserPayload.Role),
+	}
+
+	id, err := service.CreateUser(createUserRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).SendString(id)
+}
+
+func getMe(c *fiber.Ctx) error {
+	user := currentUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, servicecore.ErrUnauthenticated.Error())
+	}
+
+	return c.JSON(user)
+}
+
+func createAPIToken(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	user := currentUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, servicecore.ErrUnauthenticated.Error())
+	}
+
+	var createAPITokenPayload payloads.CreateAPITokenPayload
+
+	if err := c.BodyParser(&createAPITokenPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(createAPITokenPayload); err != nil {
+		return err
+	}
+
+	createAPITokenRequest := &contracts.CreateAPITokenRequest{
+		UserID: user.ID,
+		Name:   createAPITokenPayload.Name,
+	}
+
+	response, err := service.CreateAPIToken(createAPITokenRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+func getAPITokens(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	user := currentUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, servicecore.ErrUnauthenticated.Error())
+	}
+
+	tokens, err := service.GetUserAPITokens(user.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(tokens)
+}
+
+func revokeAPIToken(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	user := currentUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, servicecore.ErrUnauthenticated.Error())
+	}
+
+	var revokeAPITokenPayload payloads.RevokeAPITokenPayload
+
+	if err := c.BodyParser(&revokeAPITokenPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(revokeAPITokenPayload); err != nil {
+		return err
+	}
+
+	revokeAPITokenRequest := &contracts.RevokeAPITokenRequest{
+		ID:     revokeAPITokenPayload.ID,
+		UserID: user.ID,
+	}
+
+	err := service.RevokeAPIToken(revokeAPITokenRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("API token revoked successfully")
+}
+
+func getUsers(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	users, err := service.GetUsers()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users)
+}
+
+func assignRole(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var assignRolePayload payloads.AssignRolePayload
+
+	if err := c.BodyParser(&assignRolePayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(assignRolePayload); err != nil {
+		return err
+	}
+
+	assignRoleRequest := &contracts.AssignRoleRequest{
+		UserID: assignRolePayload.UserID,
+		Role:   contracts.Role(assignRolePayload.Role),
+	}
+
+	err := service.AssignRole(assignRoleRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Role assigned successfully")
+}
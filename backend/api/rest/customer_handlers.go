@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func createCustomer(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var createCustomerPayload payloads.CreateCustomerPayload
+
+	if err := c.BodyParser(&createCustomerPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(createCustomerPayload); err != nil {
+		return err
+	}
+
+	createCustomerRequest := &contracts.CreateCustomerRequest{
+		Name:    createCustomerPayload.Name,
+		Phone:   createCustomerPayload.Phone,
+		Email:   createCustomerPayload.Email,
+		Address: createCustomerPayload.Address,
+		Notes:   createCustomerPayload.Notes,
+	}
+
+	customerID, err := service.CreateCustomer(createCustomerRequest)
+	if err != nil {
+		return err
+	}
+
+	c.Status(fiber.StatusCreated)
+	return c.SendString(customerID)
+}
+
+func editCustomer(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var editCustomerPayload payloads.EditCustomerPayload
+
+	if err := c.BodyParser(&editCustomerPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(editCustomerPayload); err != nil {
+		return err
+	}
+
+	editCustomerRequest := &contracts.EditCustomerRequest{
+		ID:      editCustomerPayload.ID,
+		Name:    editCustomerPayload.Name,
+		Phone:   editCustomerPayload.Phone,
+		Email:   editCustomerPayload.Email,
+		Address: editCustomerPayload.Address,
+		Notes:   editCustomerPayload.Notes,
+	}
+
+	err := service.EditCustomer(editCustomerRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Customer updated successfully")
+}
+
+func deleteCustomer(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var deleteCustomerPayload payloads.DeleteCustomerPayload
+
+	if err := c.BodyParser(&deleteCustomerPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(deleteCustomerPayload); err != nil {
+		return err
+	}
+
+	err := service.DeleteCustomer(deleteCustomerPayload.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Customer deleted successfully")
+}
+
+func getFilteredCustomers(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getFilteredCustomersPayload payloads.GetFilteredCustomersPayload
+
+	if err := c.QueryParser(&getFilteredCustomersPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getFilteredCustomersPayload); err != nil {
+		return err
+	}
+
+	getFilteredCustomersRequest := &contracts.GetFilteredCustomersRequest{
+		Search: getFilteredCustomersPayload.Search,
+	}
+
+	customers, err := service.GetFilteredCustomers(getFilteredCustomersRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(customers)
+}
+
+func getCustomer(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	customerID := c.Params("customerID")
+	_, err := uuid.Parse(customerID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid customer ID")
+	}
+
+	customer, err := service.GetCustomer(customerID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(customer)
+}
+
+func getCustomerEvents(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	customerID := c.Params("customerID")
+	_, err := uuid.Parse(customerID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid customer ID")
+	}
+
+	events, err := service.GetCustomerEvents(customerID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(events)
+}
@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func getEventQuotePDF(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	eventID := c.Params("eventID")
+	_, err := uuid.Parse(eventID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
+	}
+
+	var getEventQuotePayload payloads.GetEventQuotePayload
+
+	if err := c.QueryParser(&getEventQuotePayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getEventQuotePayload); err != nil {
+		return err
+	}
+
+	getEventQuoteRequest := &contracts.GetEventQuoteRequest{
+		EventID:         eventID,
+		Strategy:        contracts.PackingStrategy(getEventQuotePayload.Strategy),
+		MaxWastePercent: optional(getEventQuotePayload.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(getEventQuotePayload.Sourcing),
+	}
+
+	document, err := service.RenderEventQuotePDF(getEventQuoteRequest)
+	if err != nil {
+		return err
+	}
+
+	return sendPDF(c, document, "quote-"+eventID+".pdf")
+}
+
+func getOrderSheetPDF(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	eventID := c.Params("eventID")
+	_, err := uuid.Parse(eventID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
+	}
+
+	var getFlowersInEventPayload payloads.GetFlowersInEventPayload
+
+	if err := c.QueryParser(&getFlowersInEventPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getFlowersInEventPayload); err != nil {
+		return err
+	}
+
+	getFlowersInEventRequest := &contracts.GetFlowersInEventRequest{
+		EventID:         eventID,
+		Strategy:        contracts.PackingStrategy(getFlowersInEventPayload.Strategy),
+		MaxWastePercent: optional(getFlowersInEventPayload.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(getFlowersInEventPayload.Sourcing),
+	}
+
+	document, err := service.RenderOrderSheetPDF(getFlowersInEventRequest)
+	if err != nil {
+		return err
+	}
+
+	return sendPDF(c, document, "order-sheet-"+eventID+".pdf")
+}
+
+func sendPDF(c *fiber.Ctx, document []byte, filename string) error {
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, `inline; filename="`+filename+`"`)
+	return c.Send(document)
+}
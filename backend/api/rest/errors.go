@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"errors"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+	"log"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// internalErrorMessage answers the errors of no known kind in place of their detail
+const internalErrorMessage = "internal server error"
+
+// ErrorResponse is the body of every failed request
+type ErrorResponse struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// errorHandler answers the errors returned by the handlers with the status matching their kind
+func errorHandler(c *fiber.Ctx, err error) error {
+	status, response := errorResponse(err)
+	return c.Status(status).JSON(response)
+}
+
+func errorResponse(err error) (int, ErrorResponse) {
+	response := ErrorResponse{Message: err.Error()}
+
+	var persistencyErr *persistency.Error
+	if errors.As(err, &persistencyErr) {
+		response.Fields = persistencyErr.Fields
+	}
+
+	var fiberErr *fiber.Error
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.As(err, &fiberErr):
+		response.Code = statusCode(fiberErr.Code)
+		return fiberErr.Code, response
+	case errors.As(err, &validationErrs):
+		response.Code = "validation_failed"
+		response.Fields = make(map[string]string, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			rule := fieldErr.Tag()
+			if fieldErr.Param() != "" {
+				rule += "=" + fieldErr.Param()
+			}
+			response.Fields[fieldErr.Field()] = rule
+		}
+		return fiber.StatusUnprocessableEntity, response
+	case errors.Is(err, persistency.ErrNotFound):
+		response.Code = "not_found"
+		return fiber.StatusNotFound, response
+	case errors.Is(err, persistency.ErrConflict):
+		response.Code = "conflict"
+		return fiber.StatusConflict, response
+	case errors.Is(err, persistency.ErrValidation):
+		response.Code = "validation_failed"
+		return fiber.StatusUnprocessableEntity, response
+	case errors.Is(err, persistency.ErrForeignKeyViolation):
+		response.Code = "foreign_key_violation"
+		return fiber.StatusUnprocessableEntity, response
+	case errors.Is(err, servicecore.ErrForbidden):
+		response.Code = "forbidden"
+		return fiber.StatusForbidden, response
+	case errors.Is(err, servicecore.ErrUnauthenticated), errors.Is(err, servicecore.ErrInvalidCredentials):
+		response.Code = "unauthorized"
+		return fiber.StatusUnauthorized, response
+	}
+
+	// the detail of an unexpected error can hold SQL or other internals of the server, it is only logged
+	log.Printf("internal error: %v", err)
+	response.Code = "internal"
+	response.Message = internalErrorMessage
+	response.Fields = nil
+	return fiber.StatusInternalServerError, response
+}
+
+// statusCode returns the code of a status as in "Bad Request" turning into "bad_request"
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(utils.StatusMessage(status)), " ", "_")
+}
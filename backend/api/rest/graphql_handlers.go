@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"flower-management/api/graphql"
+	"flower-management/api/rest/payloads"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	gqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// executeGraphQL answers a GraphQL query, the errors of the resolvers carry the code and fields the
+// REST routes answer the same error with in their extensions
+func executeGraphQL(c *fiber.Ctx, schema *gqlgo.Schema, service *servicecore.ServiceCore) error {
+	var graphQLPayload payloads.GraphQLPayload
+
+	if err := c.BodyParser(&graphQLPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(graphQLPayload); err != nil {
+		return err
+	}
+
+	ctx := graphql.NewContext(c.UserContext(), service)
+	response := schema.Exec(ctx, graphQLPayload.Query, graphQLPayload.OperationName, graphQLPayload.Variables)
+
+	for _, queryErr := range response.Errors {
+		if queryErr.ResolverError == nil {
+			continue
+		}
+		_, errResponse := errorResponse(queryErr.ResolverError)
+		queryErr.Message = errResponse.Message
+		queryErr.Extensions = map[string]any{"code": errResponse.Code}
+		if len(errResponse.Fields) > 0 {
+			queryErr.Extensions["fields"] = errResponse.Fields
+		}
+	}
+
+	return c.JSON(response)
+}
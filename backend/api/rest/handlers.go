@@ -4,6 +4,7 @@ import (
 	"flower-management/api/rest/payloads"
 	"flower-management/contracts"
 	"flower-management/internal/core/servicecore"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -19,17 +20,18 @@ func createFlower(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(createFlowerPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	createFlowerRequest := &contracts.CreateFlowerRequest{
 		Name:           createFlowerPayload.Name,
+		ShelfLifeDays:  createFlowerPayload.ShelfLifeDays,
 		PackingOptions: &createFlowerPayload.PackingOptions,
 	}
 
 	flowerID, err := service.CreateFlower(createFlowerRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	c.Status(fiber.StatusCreated)
@@ -45,7 +47,7 @@ func createProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(createProductPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	createProductRequest := &contracts.CreateProductRequest{
@@ -55,7 +57,7 @@ func createProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	productID, err := service.CreateProduct(createProductRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	c.Status(fiber.StatusCreated)
@@ -71,12 +73,13 @@ func createEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(createEventPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	createEventRequest := &contracts.CreateEventRequest{
 		Name:        createEventPayload.Name,
 		Date:        createEventPayload.Date,
+		CustomerID:  createEventPayload.CustomerID,
 		Phone:       createEventPayload.Phone,
 		Email:       createEventPayload.Email,
 		Address:     createEventPayload.Address,
@@ -85,7 +88,7 @@ func createEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	eventID, err := service.CreateEvent(createEventRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	c.Status(fiber.StatusCreated)
@@ -101,17 +104,18 @@ func editFlower(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(editFlowerPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	editFlowerRequest := &contracts.EditFlowerRequest{
-		ID:   editFlowerPayload.ID,
-		Name: editFlowerPayload.Name,
+		ID:            editFlowerPayload.ID,
+		Name:          editFlowerPayload.Name,
+		ShelfLifeDays: editFlowerPayload.ShelfLifeDays,
 	}
 
 	err := service.EditFlower(editFlowerRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Flower updated successfully")
@@ -126,7 +130,7 @@ func editProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(editProductPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	editProductRequest := &contracts.EditProductRequest{
@@ -137,7 +141,7 @@ func editProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	err := service.EditProduct(editProductRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Product updated successfully")
@@ -152,13 +156,14 @@ func editEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(editEventPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	editEventRequest := &contracts.EditEventRequest{
 		ID:          editEventPayload.ID,
 		Name:        editEventPayload.Name,
 		Date:        editEventPayload.Date,
+		CustomerID:  editEventPayload.CustomerID,
 		Phone:       editEventPayload.Phone,
 		Email:       editEventPayload.Email,
 		Address:     editEventPayload.Address,
@@ -167,7 +172,7 @@ func editEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	err := service.EditEvent(editEventRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Event updated successfully")
@@ -182,12 +187,12 @@ func deleteFlower(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(deleteFlowerPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	err := service.DeleteFlower(deleteFlowerPayload.ID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Flower deleted successfully")
@@ -202,12 +207,12 @@ func deleteProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(deleteProductPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	err := service.DeleteProduct(deleteProductPayload.ID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Product deleted successfully")
@@ -222,12 +227,12 @@ func deleteEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(deleteEventPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	err := service.DeleteEvent(deleteEventPayload.ID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Event deleted successfully")
@@ -236,72 +241,106 @@ func deleteEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 func getFilteredFlowers(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 	var getFilteredFlowersPayload payloads.GetFilteredFlowersPayload
 
-	if err := c.BodyParser(&getFilteredFlowersPayload); err != nil {
+	if err := c.QueryParser(&getFilteredFlowersPayload); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
 	validate := validator.New()
 	if err := validate.Struct(getFilteredFlowersPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	getFilteredFlowersRequest := &contracts.GetFilteredFlowersRequest{
-		Name: getFilteredFlowersPayload.Name,
+		IDs:            getFilteredFlowersPayload.IDs,
+		Name:           getFilteredFlowersPayload.Name,
+		Match:          contracts.MatchMode(getFilteredFlowersPayload.Match),
+		IncludeDeleted: getFilteredFlowersPayload.IncludeDeleted,
+		Page:           pageRequest(getFilteredFlowersPayload.PagePayload),
 	}
 
-	flowers, err := service.GetFilteredFlowers(getFilteredFlowersRequest)
+	flowers, page, err := service.GetFilteredFlowers(getFilteredFlowersRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
+	setPageHeaders(c, page)
 	return c.JSON(flowers)
 }
 
 func getFilteredProducts(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 	var getFilteredProductsPayload payloads.GetFilteredProductsPayload
 
-	if err := c.BodyParser(&getFilteredProductsPayload); err != nil {
+	if err := c.QueryParser(&getFilteredProductsPayload); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
 	validate := validator.New()
 	if err := validate.Struct(getFilteredProductsPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	getFilteredProductsRequest := &contracts.GetFilteredProductsRequest{
-		Name: getFilteredProductsPayload.Name,
+		IDs:            getFilteredProductsPayload.IDs,
+		Name:           getFilteredProductsPayload.Name,
+		Description:    getFilteredProductsPayload.Description,
+		Match:          contracts.MatchMode(getFilteredProductsPayload.Match),
+		IncludeDeleted: getFilteredProductsPayload.IncludeDeleted,
+		Page:           pageRequest(getFilteredProductsPayload.PagePayload),
 	}
 
-	products, err := service.GetFilteredProducts(getFilteredProductsRequest)
+	products, page, err := service.GetFilteredProducts(getFilteredProductsRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
+	setPageHeaders(c, page)
 	return c.JSON(products)
 }
 
 func getFilteredEvents(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 	var getFilteredEventsPayload payloads.GetFilteredEventsPayload
 
-	if err := c.BodyParser(&getFilteredEventsPayload); err != nil {
+	if err := c.QueryParser(&getFilteredEventsPayload); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
 	validate := validator.New()
 	if err := validate.Struct(getFilteredEventsPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	getFilteredEventsRequest := &contracts.GetFilteredEventsRequest{
-		Name: getFilteredEventsPayload.Name,
+		IDs:            getFilteredEventsPayload.IDs,
+		Name:           getFilteredEventsPayload.Name,
+		Address:        getFilteredEventsPayload.Address,
+		Description:    getFilteredEventsPayload.Description,
+		Match:          contracts.MatchMode(getFilteredEventsPayload.Match),
+		CustomerIDs:    getFilteredEventsPayload.CustomerIDs,
+		IncludeDeleted: getFilteredEventsPayload.IncludeDeleted,
+		Page:           pageRequest(getFilteredEventsPayload.PagePayload),
+	}
+	for _, status := range getFilteredEventsPayload.Statuses {
+		getFilteredEventsRequest.Statuses = append(getFilteredEventsRequest.Statuses, contracts.EventStatus(status))
 	}
 
-	events, err := service.GetFilteredEvents(getFilteredEventsRequest)
+	// the dates were validated above, the to date includes its whole day
+	if getFilteredEventsPayload.Date != "" {
+		getFilteredEventsRequest.Date, _ = time.Parse(time.DateOnly, getFilteredEventsPayload.Date)
+	}
+	if getFilteredEventsPayload.From != "" {
+		getFilteredEventsRequest.DateFrom, _ = time.Parse(time.DateOnly, getFilteredEventsPayload.From)
+	}
+	if getFilteredEventsPayload.To != "" {
+		to, _ := time.Parse(time.DateOnly, getFilteredEventsPayload.To)
+		getFilteredEventsRequest.DateTo = to.AddDate(0, 0, 1)
+	}
+
+	events, page, err := service.GetFilteredEvents(getFilteredEventsRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
+	setPageHeaders(c, page)
 	return c.JSON(events)
 }
 
@@ -314,7 +353,7 @@ func getFlower(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	flower, err := service.GetFlower(flowerID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.JSON(flower)
@@ -329,7 +368,7 @@ func getProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	product, err := service.GetProduct(productID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.JSON(product)
@@ -344,7 +383,7 @@ func getEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	event, err := service.GetEvent(eventID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.JSON(event)
@@ -359,7 +398,7 @@ func addFlowersToProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(addFlowersToProductPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	addFlowersToProductRequest := &contracts.AddFlowersToProductRequest{
@@ -369,7 +408,7 @@ func addFlowersToProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	err := service.AddFlowersToProduct(addFlowersToProductRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Flowers added to product successfully")
@@ -384,7 +423,7 @@ func addProductsToEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(addProductsToEventPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	addProductsToEventRequest := &contracts.AddProductsToEventRequest{
@@ -394,7 +433,7 @@ func addProductsToEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	err := service.AddProductsToEvent(addProductsToEventRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Flowers added to product successfully")
@@ -409,7 +448,7 @@ func editFlowersInProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error
 
 	validate := validator.New()
 	if err := validate.Struct(editFlowersInProduct); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	editFlowersInProductRequest := &contracts.AddFlowersToProductRequest{
@@ -419,7 +458,7 @@ func editFlowersInProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error
 
 	err := service.EditFlowersInProduct(editFlowersInProductRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("Flowers in product updated successfully")
@@ -434,7 +473,7 @@ func editProductsInEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 
 	validate := validator.New()
 	if err := validate.Struct(editProductsInEventPayload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
 	editProductsInEventRequest := &contracts.AddProductsToEventRequest{
@@ -442,9 +481,9 @@ func editProductsInEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 		Products: &editProductsInEventPayload.Products,
 	}
 
-	err := service.AddProductsToEvent(editProductsInEventRequest)
+	err := service.EditProductsInEvent(editProductsInEventRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.SendString("products in event updated successfully")
@@ -457,10 +496,101 @@ func getFlowersInEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
 	}
 
-	flowers, err := service.GetFlowersInEvent(eventID)
+	var getFlowersInEventPayload payloads.GetFlowersInEventPayload
+
+	if err := c.QueryParser(&getFlowersInEventPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getFlowersInEventPayload); err != nil {
+		return err
+	}
+
+	getFlowersInEventRequest := &contracts.GetFlowersInEventRequest{
+		EventID:         eventID,
+		Strategy:        contracts.PackingStrategy(getFlowersInEventPayload.Strategy),
+		MaxWastePercent: optional(getFlowersInEventPayload.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(getFlowersInEventPayload.Sourcing),
+	}
+
+	flowers, err := service.GetFlowersInEvent(getFlowersInEventRequest)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return c.JSON(flowers)
 }
+
+func getEventQuote(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	eventID := c.Params("eventID")
+	_, err := uuid.Parse(eventID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
+	}
+
+	var getEventQuotePayload payloads.GetEventQuotePayload
+
+	if err := c.QueryParser(&getEventQuotePayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getEventQuotePayload); err != nil {
+		return err
+	}
+
+	getEventQuoteRequest := &contracts.GetEventQuoteRequest{
+		EventID:         eventID,
+		Strategy:        contracts.PackingStrategy(getEventQuotePayload.Strategy),
+		MaxWastePercent: optional(getEventQuotePayload.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(getEventQuotePayload.Sourcing),
+	}
+
+	quote, err := service.GetEventQuote(getEventQuoteRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(quote)
+}
+
+func transitionEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	eventID := c.Params("eventID")
+	_, err := uuid.Parse(eventID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
+	}
+
+	var transitionEventPayload payloads.TransitionEventPayload
+
+	if err := c.BodyParser(&transitionEventPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(transitionEventPayload); err != nil {
+		return err
+	}
+
+	transitionEventRequest := &contracts.TransitionEventRequest{
+		ID:     eventID,
+		Status: contracts.EventStatus(transitionEventPayload.Status),
+	}
+
+	err = service.TransitionEvent(transitionEventRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Event updated successfully")
+}
+
+// optional dereferences an optional field of a payload, unset fields take the zero value
+func optional[T any](field *T) T {
+	if field == nil {
+		var zero T
+		return zero
+	}
+	return *field
+}
@@ -0,0 +1,189 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestWasteLimitIsRequiredByItsStrategy(t *testing.T) {
+	app := newTestApp(false)
+
+	tests := []struct {
+		name        string
+		query       string
+		wantInvalid bool
+	}{
+		{name: "missing for its strategy", query: "strategy=cheapest-within-waste", wantInvalid: true},
+		{name: "given for its strategy", query: "strategy=cheapest-within-waste&max_waste_percent=10"},
+		{name: "zero waste for its strategy", query: "strategy=cheapest-within-waste&max_waste_percent=0"},
+		{name: "negative", query: "strategy=cheapest-within-waste&max_waste_percent=-1", wantInvalid: true},
+		{name: "not needed by other strategies", query: "strategy=cheapest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := "/event/flowers/6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10?" + tt.query
+			response, err := app.Test(httptest.NewRequest(fiber.MethodGet, url, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer response.Body.Close()
+
+			var errorResponse ErrorResponse
+			json.NewDecoder(response.Body).Decode(&errorResponse)
+			invalid := response.StatusCode == fiber.StatusUnprocessableEntity && errorResponse.Fields["MaxWastePercent"] != ""
+			if invalid != tt.wantInvalid {
+				t.Errorf("got status %d %+v, want the waste limit to be blamed: %v", response.StatusCode, errorResponse, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func TestFlowerAndProductCountsAreBounded(t *testing.T) {
+	app := newTestApp(false)
+
+	tests := []struct {
+		name        string
+		url         string
+		body        string
+		wantInvalid bool
+	}{
+		{name: "flowers in a product", url: "/product/flowers", body: `{"product_id": "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", "flowers": [{"flower_id": "rose", "num_of_flowers": 12}]}`},
+		{name: "no flowers in a product", url: "/product/flowers", body: `{"product_id": "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", "flowers": [{"flower_id": "rose", "num_of_flowers": 0}]}`, wantInvalid: true},
+		{name: "too many flowers in a product", url: "/product/flowers", body: `{"product_id": "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", "flowers": [{"flower_id": "rose", "num_of_flowers": 1001}]}`, wantInvalid: true},
+		{name: "products in an event", url: "/event/products", body: `{"event_id": "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", "products": [{"product_id": "bouquet", "quantity": 3}]}`},
+		{name: "negative product quantity", url: "/event/products", body: `{"event_id": "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", "products": [{"product_id": "bouquet", "quantity": -3}]}`, wantInvalid: true},
+		{name: "too many products in an event", url: "/event/products", body: `{"event_id": "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", "products": [{"product_id": "bouquet", "quantity": 10001}]}`, wantInvalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(fiber.MethodPost, tt.url, strings.NewReader(tt.body))
+			request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			response, err := app.Test(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer response.Body.Close()
+
+			if invalid := response.StatusCode == fiber.StatusUnprocessableEntity; invalid != tt.wantInvalid {
+				t.Errorf("got status %d, want the count rejected: %v", response.StatusCode, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func TestCustomerEventsRoute(t *testing.T) {
+	app := newTestApp(false)
+
+	send := func(method, url, body string) *http.Response {
+		request := httptest.NewRequest(method, url, strings.NewReader(body))
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		response, err := app.Test(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return response
+	}
+	readBody := func(response *http.Response) string {
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		return string(body)
+	}
+
+	response := send(fiber.MethodPost, "/customer", `{"Name": "Dana Levi", "Phone": "050-1234567"}`)
+	customerID := readBody(response)
+	if response.StatusCode != fiber.StatusCreated {
+		t.Fatalf("creating the customer got status %d: %s", response.StatusCode, customerID)
+	}
+	for _, name := range []string{"Wedding", "Birthday"} {
+		body := fmt.Sprintf(`{"Name": %q, "Date": "2026-06-01T00:00:00Z", "customer_id": %q, "Address": "Hall", "Description": "White roses"}`, name, customerID)
+		if response := send(fiber.MethodPost, "/event", body); response.StatusCode != fiber.StatusCreated {
+			t.Fatalf("creating the %s got status %d: %s", name, response.StatusCode, readBody(response))
+		}
+	}
+
+	response = send(fiber.MethodGet, "/customer/"+customerID+"/events", "")
+	var events []struct{ Name, Phone string }
+	if err := json.Unmarshal([]byte(readBody(response)), &events); err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != fiber.StatusOK || len(events) != 2 || events[0].Phone != "050-1234567" {
+		t.Errorf("expected both events with the phone of the customer, got status %d %+v", response.StatusCode, events)
+	}
+
+	tests := []struct {
+		name       string
+		customerID string
+		wantStatus int
+	}{
+		{name: "missing customer", customerID: "6b0f7d7e-3f3c-4c55-a0e4-5d9a4b6c2f10", wantStatus: fiber.StatusNotFound},
+		{name: "malformed ID", customerID: "dana", wantStatus: fiber.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		if response := send(fiber.MethodGet, "/customer/"+tt.customerID+"/events", ""); response.StatusCode != tt.wantStatus {
+			t.Errorf("%s: got status %d, want %d", tt.name, response.StatusCode, tt.wantStatus)
+		}
+	}
+}
+
+func TestEditProductsInEventIsAudited(t *testing.T) {
+	app := newTestApp(false)
+
+	send := func(method, url, body string) string {
+		request := httptest.NewRequest(method, url, strings.NewReader(body))
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		response, err := app.Test(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		responseBody, _ := io.ReadAll(response.Body)
+		if response.StatusCode >= fiber.StatusBadRequest {
+			t.Fatalf("%s %s got status %d: %s", method, url, response.StatusCode, responseBody)
+		}
+		return string(responseBody)
+	}
+
+	productID := send(fiber.MethodPost, "/product", `{"Name": "Bouquet"}`)
+	eventID := send(fiber.MethodPost, "/event", `{"Name": "Wedding", "Date": "2026-06-01T00:00:00Z", "Address": "Hall", "Description": "White roses"}`)
+	products := func(quantity int) string {
+		return fmt.Sprintf(`{"event_id": %q, "products": [{"product_id": %q, "quantity": %d}]}`, eventID, productID, quantity)
+	}
+	send(fiber.MethodPost, "/event/products", products(3))
+	send(fiber.MethodPut, "/event/products", products(5))
+
+	var records []struct {
+		Action        string
+		Before, After struct{ Products []struct{ Quantity int } }
+	}
+	if err := json.Unmarshal([]byte(send(fiber.MethodGet, "/audit?entity=event&id="+eventID, "")), &records); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) == 0 {
+		t.Fatal("the event should have audit records")
+	}
+	edit := records[len(records)-1]
+	if edit.Action != "edit" || len(edit.Before.Products) != 1 || edit.Before.Products[0].Quantity != 3 ||
+		len(edit.After.Products) != 1 || edit.After.Products[0].Quantity != 5 {
+		t.Errorf("editing the products of the event should record the quantity going from 3 to 5, got %+v", edit)
+	}
+}
+
+func TestInternalErrorsHideTheirDetail(t *testing.T) {
+	status, response := errorResponse(fmt.Errorf("failed to get flower: %w", errors.New(`relation "flowers" does not exist`)))
+	if status != fiber.StatusInternalServerError || response.Code != "internal" {
+		t.Fatalf("got status %d with code %q, want an internal error", status, response.Code)
+	}
+	if response.Message != internalErrorMessage {
+		t.Errorf("the detail of an internal error should not be answered, got %q", response.Message)
+	}
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"context"
+	"flower-management/internal/core/servicecore"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// The states of the lifecycle of the server, it only takes traffic while ready
+const (
+	lifecycleStarting = "starting"
+	lifecycleReady    = "ready"
+	lifecycleStopping = "stopping"
+)
+
+// readinessTimeout bounds the checks of /readyz, a database that does not answer in time is down
+const readinessTimeout = 2 * time.Second
+
+// lifecycle is the state of the server reported by /readyz
+type lifecycle struct {
+	state atomic.Value
+}
+
+func newLifecycle() *lifecycle {
+	l := &lifecycle{}
+	l.set(lifecycleStarting)
+	return l
+}
+
+func (l *lifecycle) set(state string) {
+	l.state.Store(state)
+}
+
+func (l *lifecycle) get() string {
+	return l.state.Load().(string)
+}
+
+type HealthResponse struct {
+	Status string
+}
+
+// ReadinessResponse is the body of /readyz, Status is "ready" when the server is ready, the database
+// answers and no migration is pending. The route is public so it tells nothing more, the reason a server
+// is not ready goes to the log.
+type ReadinessResponse struct {
+	Status string
+}
+
+// healthz answers as long as the process serves requests, the dependencies are checked by readyz
+func healthz(c *fiber.Ctx) error {
+	return c.JSON(HealthResponse{Status: "ok"})
+}
+
+// readyz answers 503 while the server starts or stops, the database is down or migrations are pending,
+// so the orchestrator routes no traffic to the server
+func readyz(c *fiber.Ctx, service *servicecore.ServiceCore, lifecycle *lifecycle) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), readinessTimeout)
+	defer cancel()
+
+	// starting and stopping are expected, they are not worth a log line at every probe
+	if lifecycle.get() != lifecycleReady {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(ReadinessResponse{Status: "not_ready"})
+	}
+
+	health, err := service.CheckHealth(ctx)
+	if err != nil {
+		return notReady(c, "database is down: %v", err)
+	}
+	if pending := health.Migrations.Pending; len(pending) > 0 {
+		return notReady(c, "migrations are pending: %v", pending)
+	}
+
+	return c.JSON(ReadinessResponse{Status: "ready"})
+}
+
+// notReady logs why the server is not ready and answers 503
+func notReady(c *fiber.Ctx, format string, args ...any) error {
+	log.Printf("not ready: "+format, args...)
+	return c.Status(fiber.StatusServiceUnavailable).JSON(ReadinessResponse{Status: "not_ready"})
+}
@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"flower-management/internal/core/config"
+	"flower-management/internal/core/servicecore/servicecoretest"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// unhealthyDal reports the health it is given
+type unhealthyDal struct {
+	persistency.DalInterface
+	health *persistency.Health
+	err    error
+}
+
+func (d *unhealthyDal) CheckHealth(ctx context.Context) (*persistency.Health, error) {
+	return d.health, d.err
+}
+
+func readiness(t *testing.T, dal persistency.DalInterface, state string) (int, ReadinessResponse) {
+	app := fiber.New()
+	service := servicecoretest.NewWithAuth(dal, &config.AuthConfig{Enabled: true})
+	lifecycle := newLifecycle()
+	lifecycle.set(state)
+	defineRoutes(app, service, lifecycle)
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var readinessResponse ReadinessResponse
+	if err := json.NewDecoder(response.Body).Decode(&readinessResponse); err != nil {
+		t.Fatal(err)
+	}
+	return response.StatusCode, readinessResponse
+}
+
+func TestReadiness(t *testing.T) {
+	status, response := readiness(t, mock.NewDalMock(), lifecycleReady)
+	if status != fiber.StatusOK || response.Status != "ready" {
+		t.Errorf("a started server with a healthy database should be ready, got %d %+v", status, response)
+	}
+
+	for _, state := range []string{lifecycleStarting, lifecycleStopping} {
+		if status, _ := readiness(t, mock.NewDalMock(), state); status != fiber.StatusServiceUnavailable {
+			t.Errorf("a %s server should not be ready, got %d", state, status)
+		}
+	}
+
+	down := &unhealthyDal{DalInterface: mock.NewDalMock(), health: &persistency.Health{}, err: errors.New("connection refused to 10.0.0.5:5432")}
+	status, response = readiness(t, down, lifecycleReady)
+	if status != fiber.StatusServiceUnavailable || response != (ReadinessResponse{Status: "not_ready"}) {
+		t.Errorf("a server whose database is down should not be ready and tell nothing more, got %d %+v", status, response)
+	}
+
+	pending := &unhealthyDal{DalInterface: mock.NewDalMock(), health: &persistency.Health{
+		Migrations: persistency.MigrationStatus{Applied: 31, Latest: "31", Pending: []string{"32"}},
+	}}
+	if status, _ := readiness(t, pending, lifecycleReady); status != fiber.StatusServiceUnavailable {
+		t.Errorf("a server with pending migrations should not be ready, got %d", status)
+	}
+}
+
+func TestLivenessNeedsNoToken(t *testing.T) {
+	response, err := newTestApp(true).Test(httptest.NewRequest(fiber.MethodGet, "/healthz", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != fiber.StatusOK {
+		t.Errorf("liveness should answer without a token, got %d", response.StatusCode)
+	}
+}
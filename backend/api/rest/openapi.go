@@ -0,0 +1,503 @@
+package rest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// swaggerPage renders /openapi.json with Swagger UI
+//
+//go:embed swagger.html
+var swaggerPage string
+
+// swaggerUI holds the files of the swagger-ui dist the page loads, they are vendored so the page needs
+// no CDN
+//
+//go:embed swaggerui/swagger-ui.css swaggerui/swagger-ui-bundle.js
+var swaggerUI embed.FS
+
+// routeDoc documents a route of defineRoutes, the schemas of the document are read from its payloads
+type routeDoc struct {
+	summary string
+	// body and query are the payloads the handler reads from the request body and the query string
+	body  any
+	query any
+	// response is the JSON answer of the route, a string for the routes answering plain text
+	response any
+	// contentType is set for the routes answering neither JSON nor plain text
+	contentType string
+	// status is the status of a successful answer, 200 when unset
+	status int
+	// paged routes answer the total count and next cursor headers of their list
+	paged bool
+	// public routes are reachable without a token
+	public bool
+}
+
+// routeKey names a route in routeDocs, as in "GET /flower/:flowerID"
+func routeKey(method string, routePath string) string {
+	return method + " " + routePath
+}
+
+// documentedRoutes returns the routes of the app that carry a method, the middlewares and the HEAD
+// routes Fiber adds for every GET route are left out
+func documentedRoutes(app *fiber.App) []fiber.Route {
+	var routes []fiber.Route
+	for _, route := range app.GetRoutes(true) {
+		if route.Method == fiber.MethodHead {
+			continue
+		}
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+// undocumentedRoutes returns the routes of the app missing from routeDocs
+func undocumentedRoutes(app *fiber.App) []string {
+	var missing []string
+	for _, route := range documentedRoutes(app) {
+		if _, found := routeDocs[routeKey(route.Method, route.Path)]; !found {
+			missing = append(missing, routeKey(route.Method, route.Path))
+		}
+	}
+
+	return missing
+}
+
+// serveOpenAPI answers the document of the routes of the app, it is built on the first request once
+// every route is defined
+func serveOpenAPI(authEnabled bool) fiber.Handler {
+	var once sync.Once
+	var document []byte
+	var err error
+
+	return func(c *fiber.Ctx) error {
+		once.Do(func() {
+			document, err = json.Marshal(newOpenAPIGenerator().document(documentedRoutes(c.App()), authEnabled))
+		})
+		if err != nil {
+			return err
+		}
+
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(document)
+	}
+}
+
+func serveSwaggerUI(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.SendString(swaggerPage)
+}
+
+// serveSwaggerAsset answers a file of the vendored swagger-ui dist
+func serveSwaggerAsset(name string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		asset, err := swaggerUI.ReadFile("swaggerui/" + name)
+		if err != nil {
+			return err
+		}
+
+		c.Type(path.Ext(name))
+		return c.Send(asset)
+	}
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	rawMessageType  = reflect.TypeOf(json.RawMessage{})
+	routeParameters = regexp.MustCompile(`:(\w+)`)
+)
+
+// openAPIGenerator collects the named types met while documenting the routes as components
+type openAPIGenerator struct {
+	schemas map[string]any
+	names   map[reflect.Type]string
+}
+
+func newOpenAPIGenerator() *openAPIGenerator {
+	return &openAPIGenerator{
+		schemas: make(map[string]any),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+func (g *openAPIGenerator) document(routes []fiber.Route, authEnabled bool) map[string]any {
+	paths := make(map[string]map[string]any)
+	for _, route := range routes {
+		doc, found := routeDocs[routeKey(route.Method, route.Path)]
+		if !found {
+			continue
+		}
+
+		openAPIPath := routeParameters.ReplaceAllString(route.Path, "{$1}")
+		if paths[openAPIPath] == nil {
+			paths[openAPIPath] = make(map[string]any)
+		}
+		paths[openAPIPath][strings.ToLower(route.Method)] = g.operation(route, doc, authEnabled)
+	}
+
+	g.schemas["ErrorResponse"] = g.objectSchema(reflect.TypeOf(ErrorResponse{}), "json")
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Flower management API",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": g.schemas,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+					"description":  "A login token or an API token",
+				},
+			},
+		},
+	}
+}
+
+func (g *openAPIGenerator) operation(route fiber.Route, doc routeDoc, authEnabled bool) map[string]any {
+	var parameters []any
+	for _, name := range route.Params {
+		parameters = append(parameters, map[string]any{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]any{"type": "string", "format": "uuid"},
+		})
+	}
+	if doc.query != nil {
+		parameters = append(parameters, g.queryParameters(reflect.TypeOf(doc.query))...)
+	}
+
+	operation := map[string]any{
+		"summary":     doc.summary,
+		"operationId": operationID(route),
+		"tags":        []string{routeTag(route.Path)},
+		"responses":   g.responses(doc),
+	}
+
+	if !doc.public {
+		if authEnabled {
+			operation["security"] = []any{map[string]any{"bearerAuth": []string{}}}
+		} else {
+			parameters = append(parameters, map[string]any{
+				"name":        tenantHeader,
+				"in":          "header",
+				"description": "The tenant the request acts in, the default tenant when missing",
+				"schema":      map[string]any{"type": "string", "format": "uuid"},
+			})
+		}
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+
+	if doc.body != nil {
+		operation["requestBody"] = map[string]any{
+			"required": true,
+			"content": map[string]any{
+				fiber.MIMEApplicationJSON: map[string]any{"schema": g.schema(reflect.TypeOf(doc.body))},
+			},
+		}
+	}
+
+	return operation
+}
+
+func (g *openAPIGenerator) responses(doc routeDoc) map[string]any {
+	status := doc.status
+	if status == 0 {
+		status = fiber.StatusOK
+	}
+
+	success := map[string]any{"description": "Success"}
+	switch {
+	case doc.contentType != "":
+		success["content"] = map[string]any{
+			doc.contentType: map[string]any{"schema": map[string]any{"type": "string", "format": "binary"}},
+		}
+	case reflect.TypeOf(doc.response).Kind() == reflect.String:
+		success["content"] = map[string]any{
+			fiber.MIMETextPlain: map[string]any{"schema": map[string]any{"type": "string"}},
+		}
+	default:
+		success["content"] = map[string]any{
+			fiber.MIMEApplicationJSON: map[string]any{"schema": g.schema(reflect.TypeOf(doc.response))},
+		}
+	}
+	if doc.paged {
+		success["headers"] = map[string]any{
+			totalCountHeader: map[string]any{
+				"description": "How many rows match the filters",
+				"schema":      map[string]any{"type": "integer"},
+			},
+			nextCursorHeader: map[string]any{
+				"description": "The cursor of the next page, missing on the last page",
+				"schema":      map[string]any{"type": "string"},
+			},
+		}
+	}
+
+	return map[string]any{
+		strconv.Itoa(status): success,
+		"default": map[string]any{
+			"description": "Error",
+			"content": map[string]any{
+				fiber.MIMEApplicationJSON: map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/ErrorResponse"}},
+			},
+		},
+	}
+}
+
+// operationID names an operation after its route, as in "get_event_eventID_quote.pdf"
+func operationID(route fiber.Route) string {
+	id := strings.ToLower(route.Method)
+	for _, segment := range strings.Split(route.Path, "/") {
+		if segment = strings.TrimPrefix(segment, ":"); segment != "" {
+			id += "_" + segment
+		}
+	}
+
+	return id
+}
+
+// routeTag groups the routes by the entity of their first path segment
+func routeTag(routePath string) string {
+	segment, _, _ := strings.Cut(strings.TrimPrefix(routePath, "/"), "/")
+	return strings.TrimSuffix(segment, "s")
+}
+
+func (g *openAPIGenerator) queryParameters(t reflect.Type) []any {
+	var parameters []any
+	for _, field := range payloadFields(t, "query") {
+		schema := g.schema(field.Type)
+		parameters = append(parameters, map[string]any{
+			"name":     field.name,
+			"in":       "query",
+			"required": applyRules(schema, field.Tag.Get("validate")),
+			"schema":   schema,
+		})
+	}
+
+	return parameters
+}
+
+// schema returns the schema of a type, structs are referenced as components
+func (g *openAPIGenerator) schema(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		return map[string]any{"$ref": "#/components/schemas/" + g.component(t)}
+	}
+
+	return map[string]any{}
+}
+
+// component adds a struct to the schemas of the document once and returns its name, the name is
+// prefixed with the package when two packages share it
+func (g *openAPIGenerator) component(t reflect.Type) string {
+	if name, found := g.names[t]; found {
+		return name
+	}
+
+	name := t.Name()
+	for other := range g.names {
+		if other.Name() == t.Name() {
+			name = strings.ToUpper(path.Base(t.PkgPath())[:1]) + path.Base(t.PkgPath())[1:] + t.Name()
+			break
+		}
+	}
+
+	// the name is taken before the fields are read so self referencing types end
+	g.names[t] = name
+	g.schemas[name] = g.objectSchema(t, "json")
+	return name
+}
+
+func (g *openAPIGenerator) objectSchema(t reflect.Type, tagName string) map[string]any {
+	properties := make(map[string]any)
+	var required []string
+	for _, field := range payloadFields(t, tagName) {
+		schema := g.schema(field.Type)
+		if applyRules(schema, field.Tag.Get("validate")) {
+			required = append(required, field.name)
+		}
+		properties[field.name] = schema
+	}
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+type payloadField struct {
+	reflect.StructField
+	name string
+}
+
+// payloadFields returns the fields of a struct under the names Fiber parses them by, the fields of
+// embedded structs are inlined
+func payloadFields(t reflect.Type, tagName string) []payloadField {
+	var fields []payloadField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get(tagName), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			fields = append(fields, payloadFields(field.Type, tagName)...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, payloadField{StructField: field, name: name})
+	}
+
+	return fields
+}
+
+// applyRules documents the validate rules of a field on its schema and reports whether the field is
+// required. The rules following dive apply to the items of a list.
+func applyRules(schema map[string]any, rules string) bool {
+	if rules == "" {
+		return false
+	}
+
+	required, dived := false, false
+	target := schema
+	for _, rule := range strings.Split(rules, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		if name == "dive" {
+			items, ok := target["items"].(map[string]any)
+			if !ok {
+				break
+			}
+			target, dived = items, true
+			continue
+		}
+		if name == "required" && !dived {
+			required = true
+			continue
+		}
+		if _, isReference := target["$ref"]; isReference {
+			// siblings of a reference are ignored by OpenAPI 3.0
+			continue
+		}
+
+		switch name {
+		case "required_if":
+			field, value, _ := strings.Cut(param, " ")
+			target["description"] = fmt.Sprintf("Required when %s is %s", field, value)
+		case "oneof":
+			target["enum"] = strings.Fields(param)
+		case "uuid", "email":
+			target["format"] = name
+		case "datetime":
+			if param == "2006-01-02" {
+				target["format"] = "date"
+			} else {
+				target["pattern"] = param
+			}
+		case "min", "max", "len", "gt", "gte", "lt", "lte":
+			applyBound(target, name, param)
+		}
+	}
+
+	return required
+}
+
+// applyBound documents a bound, it limits the length of strings and lists and the value of numbers
+func applyBound(schema map[string]any, rule string, param string) {
+	bound, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	var minimum, maximum string
+	switch schema["type"] {
+	case "string":
+		minimum, maximum = "minLength", "maxLength"
+	case "array":
+		minimum, maximum = "minItems", "maxItems"
+	case "integer", "number":
+		switch rule {
+		case "min", "gte":
+			schema["minimum"] = bound
+		case "max", "lte":
+			schema["maximum"] = bound
+		case "len":
+			schema["minimum"], schema["maximum"] = bound, bound
+		case "gt":
+			schema["minimum"], schema["exclusiveMinimum"] = bound, true
+		case "lt":
+			schema["maximum"], schema["exclusiveMaximum"] = bound, true
+		}
+		return
+	default:
+		return
+	}
+
+	length := int(bound)
+	switch rule {
+	case "min", "gte":
+		schema[minimum] = length
+	case "max", "lte":
+		schema[maximum] = length
+	case "len":
+		schema[minimum], schema[maximum] = length, length
+	case "gt":
+		schema[minimum] = length + 1
+	case "lt":
+		schema[maximum] = length - 1
+	}
+}
@@ -0,0 +1,123 @@
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"flower-management/internal/core/config"
+	"flower-management/internal/core/servicecore/servicecoretest"
+	"flower-management/internal/persistency/mock"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func newTestApp(authEnabled bool) *fiber.App {
+	app := fiber.New(fiber.Config{EnableSplittingOnParsers: true, ErrorHandler: errorHandler})
+	service := servicecoretest.NewWithAuth(mock.NewDalMock(), &config.AuthConfig{Enabled: authEnabled})
+	defineRoutes(app, service, newLifecycle())
+	return app
+}
+
+func TestEveryRouteIsDocumented(t *testing.T) {
+	app := newTestApp(true)
+
+	for _, route := range undocumentedRoutes(app) {
+		t.Errorf("route %s has no documented schema in routeDocs", route)
+	}
+
+	defined := make(map[string]bool)
+	for _, route := range documentedRoutes(app) {
+		defined[routeKey(route.Method, route.Path)] = true
+	}
+	for key, doc := range routeDocs {
+		if !defined[key] {
+			t.Errorf("routeDocs documents %s which is not a route", key)
+		}
+		if doc.response == nil && doc.contentType == "" {
+			t.Errorf("route %s documents no response", key)
+		}
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	app := newTestApp(true)
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/openapi.json", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != fiber.StatusOK {
+		t.Fatalf("the document should be served without a token, got status %d", response.StatusCode)
+	}
+
+	var document struct {
+		Paths      map[string]map[string]json.RawMessage
+		Components struct {
+			Schemas map[string]struct {
+				Required   []string
+				Properties map[string]map[string]any
+			}
+		}
+	}
+	if err := json.NewDecoder(response.Body).Decode(&document); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := document.Paths["/flower/{flowerID}"]["get"]; !found {
+		t.Errorf("path parameters should be written the OpenAPI way")
+	}
+
+	createUser := document.Components.Schemas["CreateUserPayload"]
+	if len(createUser.Required) != 3 {
+		t.Errorf("every field of CreateUserPayload is required, got %v", createUser.Required)
+	}
+	password := createUser.Properties["password"]
+	if password["minLength"] != float64(8) || password["maxLength"] != float64(72) {
+		t.Errorf("the password should carry its length bounds, got %v", password)
+	}
+	role, _ := createUser.Properties["role"]["enum"].([]any)
+	if len(role) != 5 {
+		t.Errorf("the role should list the roles, got %v", createUser.Properties["role"])
+	}
+
+	packingOptions := document.Components.Schemas["CreateFlowerPayload"].Properties["PackingOptions"]
+	if packingOptions["minItems"] != float64(1) {
+		t.Errorf("the packing options should require one option, got %v", packingOptions)
+	}
+}
+
+func TestSwaggerUIIsServedLocally(t *testing.T) {
+	app := newTestApp(true)
+
+	tests := []struct {
+		path        string
+		contentType string
+	}{
+		{path: "/docs", contentType: fiber.MIMETextHTMLCharsetUTF8},
+		{path: "/docs/swagger-ui.css", contentType: "text/css"},
+		{path: "/docs/swagger-ui-bundle.js", contentType: "text/javascript"},
+	}
+
+	for _, tt := range tests {
+		response, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.StatusCode != fiber.StatusOK || len(body) == 0 {
+			t.Errorf("%s should be served without a token, got status %d with %d bytes", tt.path, response.StatusCode, len(body))
+		}
+		if contentType := response.Header.Get(fiber.HeaderContentType); !strings.HasPrefix(contentType, tt.contentType) {
+			t.Errorf("%s got content type %q, want %q", tt.path, contentType, tt.contentType)
+		}
+		if tt.path == "/docs" && strings.Contains(string(body), "https://") {
+			t.Errorf("the page should not load anything from another origin")
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	totalCountHeader = "X-Total-Count"
+	nextCursorHeader = "X-Next-Cursor"
+)
+
+// pageRequest converts the limit, cursor and sort read from the query string of a list request
+func pageRequest(pagePayload payloads.PagePayload) contracts.PageRequest {
+	page := contracts.PageRequest{
+		Limit:  pagePayload.Limit,
+		Cursor: pagePayload.Cursor,
+	}
+	for _, field := range strings.Split(pagePayload.Sort, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		page.Sort = append(page.Sort, contracts.SortField{
+			Field:      strings.TrimPrefix(field, "-"),
+			Descending: strings.HasPrefix(field, "-"),
+		})
+	}
+
+	return page
+}
+
+// setPageHeaders tells the client how many rows match and where the next page starts
+func setPageHeaders(c *fiber.Ctx, page *persistency.Page) {
+	c.Set(totalCountHeader, strconv.Itoa(page.Total))
+	if page.NextCursor != "" {
+		c.Set(nextCursorHeader, page.NextCursor)
+	}
+}
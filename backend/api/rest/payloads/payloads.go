@@ -7,6 +7,7 @@ import (
 
 type CreateFlowerPayload struct {
 	Name           string                     `validate:"required"`
+	ShelfLifeDays  int                        `json:"shelf_life_days" validate:"gte=0"`
 	PackingOptions []contracts.PackingOptions `validate:"required,min=1,dive"`
 }
 
@@ -15,9 +16,25 @@ type CreateProductPayload struct {
 	Description string
 }
 
+type CreateSupplierPayload struct {
+	Name    string `validate:"required"`
+	Phone   string
+	Email   string `validate:"omitempty,email"`
+	Address string
+}
+
+type CreateCustomerPayload struct {
+	Name    string `validate:"required"`
+	Phone   string
+	Email   string `validate:"omitempty,email"`
+	Address string
+	Notes   string
+}
+
 type CreateEventPayload struct {
 	Name        string    `validate:"required"`
 	Date        time.Time `validate:"required"`
+	CustomerID  string    `json:"customer_id" validate:"omitempty,uuid"`
 	Phone       string
 	Email       string
 	Address     string `validate:"required"`
@@ -25,8 +42,9 @@ type CreateEventPayload struct {
 }
 
 type EditFlowerPayload struct {
-	ID   string `validate:"required,uuid"`
-	Name string
+	ID            string `validate:"required,uuid"`
+	Name          string
+	ShelfLifeDays int `json:"shelf_life_days" validate:"gte=0"`
 }
 
 type EditProductPayload struct {
@@ -35,10 +53,28 @@ type EditProductPayload struct {
 	Description string
 }
 
+type EditSupplierPayload struct {
+	ID      string `validate:"required,uuid"`
+	Name    string
+	Phone   string
+	Email   string `validate:"omitempty,email"`
+	Address string
+}
+
+type EditCustomerPayload struct {
+	ID      string `validate:"required,uuid"`
+	Name    string
+	Phone   string
+	Email   string `validate:"omitempty,email"`
+	Address string
+	Notes   string
+}
+
 type EditEventPayload struct {
 	ID          string `validate:"required,uuid"`
 	Name        string
 	Date        time.Time
+	CustomerID  string `json:"customer_id" validate:"omitempty,uuid"`
 	Phone       string
 	Email       string
 	Address     string
@@ -57,21 +93,72 @@ type DeleteEventPayload struct {
 	ID string `validate:"required,uuid"`
 }
 
+type RestoreFlowerPayload struct {
+	ID string `validate:"required,uuid"`
+}
+
+type RestoreProductPayload struct {
+	ID string `validate:"required,uuid"`
+}
+
+type RestoreEventPayload struct {
+	ID string `validate:"required,uuid"`
+}
+
+type DeleteSupplierPayload struct {
+	ID string `validate:"required,uuid"`
+}
+
+type DeleteCustomerPayload struct {
+	ID string `validate:"required,uuid"`
+}
+
+// The list filters are read from the query string. List filters repeat their parameter, or
+// separate their values with commas.
 type GetFilteredFlowersPayload struct {
-	Name                  string
-	NumOfFlowersInPackage int
+	IDs            []string `query:"id" validate:"omitempty,dive,uuid"`
+	Name           string   `query:"name"`
+	Match          string   `query:"match" validate:"omitempty,oneof=contains prefix exact"`
+	IncludeDeleted bool     `query:"include_deleted"`
+	PagePayload
 }
 
 type GetFilteredProductsPayload struct {
-	Name        string
-	Description string
+	IDs            []string `query:"id" validate:"omitempty,dive,uuid"`
+	Name           string   `query:"name"`
+	Description    string   `query:"description"`
+	Match          string   `query:"match" validate:"omitempty,oneof=contains prefix exact"`
+	IncludeDeleted bool     `query:"include_deleted"`
+	PagePayload
 }
 
 type GetFilteredEventsPayload struct {
-	Name        string
-	Date        time.Time
-	Address     string
-	Description string
+	IDs            []string `query:"id" validate:"omitempty,dive,uuid"`
+	Name           string   `query:"name"`
+	Address        string   `query:"address"`
+	Description    string   `query:"description"`
+	Match          string   `query:"match" validate:"omitempty,oneof=contains prefix exact"`
+	Date           string   `query:"date" validate:"omitempty,datetime=2006-01-02"`
+	From           string   `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To             string   `query:"to" validate:"omitempty,datetime=2006-01-02"`
+	CustomerIDs    []string `query:"customer_id" validate:"omitempty,dive,uuid"`
+	Statuses       []string `query:"status" validate:"omitempty,dive,oneof=inquiry quoted confirmed in-production delivered closed cancelled"`
+	IncludeDeleted bool     `query:"include_deleted"`
+	PagePayload
+}
+
+type GetFilteredCustomersPayload struct {
+	Search string `query:"search"`
+}
+
+type GetFilteredSuppliersPayload struct {
+	Name string `query:"name"`
+}
+
+type SetFlowerPackingOptionsPayload struct {
+	FlowerID       string                     `json:"flower_id" validate:"required,uuid"`
+	SupplierID     string                     `json:"supplier_id" validate:"required,uuid"`
+	PackingOptions []contracts.PackingOptions `json:"packing_options" validate:"required,min=1,dive"`
 }
 
 type AddFlowersToProductPayload struct {
@@ -83,3 +170,129 @@ type AddProductsToEventPayload struct {
 	EventID  string                     `json:"event_id" validate:"required,uuid"`
 	Products []contracts.ProductInEvent `json:"products" validate:"required,dive"`
 }
+
+type GetFlowersInEventPayload struct {
+	Strategy        string   `query:"strategy" validate:"omitempty,oneof=cheapest least-waste fewest-packages cheapest-within-waste"`
+	MaxWastePercent *float64 `query:"max_waste_percent" validate:"required_if=Strategy cheapest-within-waste,omitempty,gte=0"`
+	Sourcing        string   `query:"sourcing" validate:"omitempty,oneof=cheapest-supplier split-suppliers"`
+}
+
+type CreatePurchaseOrdersPayload struct {
+	EventID         string   `json:"event_id" validate:"required,uuid"`
+	Strategy        string   `json:"strategy" validate:"omitempty,oneof=cheapest least-waste fewest-packages cheapest-within-waste"`
+	MaxWastePercent *float64 `json:"max_waste_percent" validate:"required_if=Strategy cheapest-within-waste,omitempty,gte=0"`
+	Sourcing        string   `json:"sourcing" validate:"omitempty,oneof=cheapest-supplier split-suppliers"`
+}
+
+type GetFilteredPurchaseOrdersPayload struct {
+	EventID    string `query:"event_id" validate:"omitempty,uuid"`
+	SupplierID string `query:"supplier_id" validate:"omitempty,uuid"`
+	Status     string `query:"status" validate:"omitempty,oneof=draft sent confirmed received"`
+}
+
+type TransitionPurchaseOrderPayload struct {
+	ID     string `json:"id" validate:"required,uuid"`
+	Status string `json:"status" validate:"required,oneof=draft sent confirmed received"`
+}
+
+type TransitionEventPayload struct {
+	Status string `json:"status" validate:"required,oneof=inquiry quoted confirmed in-production delivered closed cancelled"`
+}
+
+type GetAuditRecordsPayload struct {
+	EntityType string `query:"entity" validate:"omitempty,oneof=flower product event supplier customer purchase-order stock-lot quote user api-token"`
+	EntityID   string `query:"id" validate:"omitempty,uuid"`
+}
+
+type CreateQuotePayload struct {
+	EventID         string   `json:"event_id" validate:"required,uuid"`
+	Strategy        string   `json:"strategy" validate:"omitempty,oneof=cheapest least-waste fewest-packages cheapest-within-waste"`
+	MaxWastePercent *float64 `json:"max_waste_percent" validate:"required_if=Strategy cheapest-within-waste,omitempty,gte=0"`
+	Sourcing        string   `json:"sourcing" validate:"omitempty,oneof=cheapest-supplier split-suppliers"`
+}
+
+type TransitionQuotePayload struct {
+	ID     string `json:"id" validate:"required,uuid"`
+	Status string `json:"status" validate:"required,oneof=draft sent accepted rejected expired"`
+}
+
+type DiffQuotesPayload struct {
+	FromVersion int `query:"from" validate:"required,gt=0"`
+	ToVersion   int `query:"to" validate:"required,gt=0"`
+}
+
+type AddStockEntryPayload struct {
+	FlowerID   string    `json:"flower_id" validate:"required,uuid"`
+	EntryType  string    `json:"entry_type" validate:"required,oneof=receipt allocation waste adjustment"`
+	Quantity   int       `json:"quantity" validate:"required"`
+	EventID    string    `json:"event_id" validate:"required_if=EntryType allocation,omitempty,uuid"`
+	LotID      string    `json:"lot_id" validate:"required_if=EntryType adjustment,omitempty,uuid"`
+	ReceivedAt time.Time `json:"received_at"`
+	Note       string    `json:"note"`
+}
+
+type GetStockEntriesPayload struct {
+	FlowerID  string `query:"flower_id" validate:"omitempty,uuid"`
+	EventID   string `query:"event_id" validate:"omitempty,uuid"`
+	LotID     string `query:"lot_id" validate:"omitempty,uuid"`
+	EntryType string `query:"entry_type" validate:"omitempty,oneof=receipt allocation waste adjustment"`
+}
+
+type GetStockLotsPayload struct {
+	FlowerID      string `query:"flower_id" validate:"omitempty,uuid"`
+	OnlyAvailable bool   `query:"only_available"`
+}
+
+type GetExpiringStockLotsPayload struct {
+	Before string `query:"before" validate:"required,datetime=2006-01-02"`
+}
+
+type GetEventQuotePayload struct {
+	Strategy        string   `query:"strategy" validate:"omitempty,oneof=cheapest least-waste fewest-packages cheapest-within-waste"`
+	MaxWastePercent *float64 `query:"max_waste_percent" validate:"required_if=Strategy cheapest-within-waste,omitempty,gte=0"`
+	Sourcing        string   `query:"sourcing" validate:"omitempty,oneof=cheapest-supplier split-suppliers"`
+}
+
+// PagePayload is read from the query string of the list endpoints. Sort is a comma separated list of
+// fields, a field starting with '-' sorts descending.
+type PagePayload struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=500"`
+	Cursor string `query:"cursor"`
+	Sort   string `query:"sort"`
+}
+
+type SearchPayload struct {
+	Query string `query:"q" validate:"required,max=200"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+type GraphQLPayload struct {
+	Query         string         `json:"query" validate:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type LoginPayload struct {
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// CreateUserPayload caps the password at 72 bytes, bcrypt ignores anything longer
+type CreateUserPayload struct {
+	Username string `json:"username" validate:"required,min=3,max=50"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	Role     string `json:"role" validate:"required,oneof=owner planner florist buyer read-only"`
+}
+
+type AssignRolePayload struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+	Role   string `json:"role" validate:"required,oneof=owner planner florist buyer read-only"`
+}
+
+type CreateAPITokenPayload struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type RevokeAPITokenPayload struct {
+	ID string `validate:"required,uuid"`
+}
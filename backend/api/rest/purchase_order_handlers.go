@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func createPurchaseOrders(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var createPurchaseOrdersPayload payloads.CreatePurchaseOrdersPayload
+
+	if err := c.BodyParser(&createPurchaseOrdersPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(createPurchaseOrdersPayload); err != nil {
+		return err
+	}
+
+	createPurchaseOrdersRequest := &contracts.CreatePurchaseOrdersRequest{
+		EventID:         createPurchaseOrdersPayload.EventID,
+		Strategy:        contracts.PackingStrategy(createPurchaseOrdersPayload.Strategy),
+		MaxWastePercent: optional(createPurchaseOrdersPayload.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(createPurchaseOrdersPayload.Sourcing),
+	}
+
+	purchaseOrders, err := service.CreatePurchaseOrders(createPurchaseOrdersRequest)
+	if err != nil {
+		return err
+	}
+
+	c.Status(fiber.StatusCreated)
+	return c.JSON(purchaseOrders)
+}
+
+func getFilteredPurchaseOrders(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getFilteredPurchaseOrdersPayload payloads.GetFilteredPurchaseOrdersPayload
+
+	if err := c.QueryParser(&getFilteredPurchaseOrdersPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getFilteredPurchaseOrdersPayload); err != nil {
+		return err
+	}
+
+	getFilteredPurchaseOrdersRequest := &contracts.GetFilteredPurchaseOrdersRequest{
+		EventID:    getFilteredPurchaseOrdersPayload.EventID,
+		SupplierID: getFilteredPurchaseOrdersPayload.SupplierID,
+		Status:     contracts.PurchaseOrderStatus(getFilteredPurchaseOrdersPayload.Status),
+	}
+
+	purchaseOrders, err := service.GetFilteredPurchaseOrders(getFilteredPurchaseOrdersRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(purchaseOrders)
+}
+
+func getPurchaseOrder(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	purchaseOrderID := c.Params("purchaseOrderID")
+	_, err := uuid.Parse(purchaseOrderID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid purchase order ID")
+	}
+
+	purchaseOrder, err := service.GetPurchaseOrder(purchaseOrderID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(purchaseOrder)
+}
+
+func transitionPurchaseOrder(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var transitionPurchaseOrderPayload payloads.TransitionPurchaseOrderPayload
+
+	if err := c.BodyParser(&transitionPurchaseOrderPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(transitionPurchaseOrderPayload); err != nil {
+		return err
+	}
+
+	transitionPurchaseOrderRequest := &contracts.TransitionPurchaseOrderRequest{
+		ID:     transitionPurchaseOrderPayload.ID,
+		Status: contracts.PurchaseOrderStatus(transitionPurchaseOrderPayload.Status),
+	}
+
+	err := service.TransitionPurchaseOrder(transitionPurchaseOrderRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Purchase order updated successfully")
+}
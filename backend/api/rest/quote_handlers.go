@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func createQuoteVersion(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var createQuotePayload payloads.CreateQuotePayload
+
+	if err := c.BodyParser(&createQuotePayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(createQuotePayload); err != nil {
+		return err
+	}
+
+	createQuoteRequest := &contracts.CreateQuoteRequest{
+		EventID:         createQuotePayload.EventID,
+		Strategy:        contracts.PackingStrategy(createQuotePayload.Strategy),
+		MaxWastePercent: optional(createQuotePayload.MaxWastePercent),
+		Sourcing:        contracts.SourcingMode(createQuotePayload.Sourcing),
+	}
+
+	quote, err := service.CreateQuoteVersion(createQuoteRequest)
+	if err != nil {
+		return err
+	}
+
+	c.Status(fiber.StatusCreated)
+	return c.JSON(quote)
+}
+
+func getEventQuotes(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	eventID := c.Params("eventID")
+	_, err := uuid.Parse(eventID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
+	}
+
+	quotes, err := service.GetEventQuotes(eventID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(quotes)
+}
+
+func getQuote(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	quoteID := c.Params("quoteID")
+	_, err := uuid.Parse(quoteID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid quote ID")
+	}
+
+	quote, err := service.GetQuote(quoteID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(quote)
+}
+
+func transitionQuote(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var transitionQuotePayload payloads.TransitionQuotePayload
+
+	if err := c.BodyParser(&transitionQuotePayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(transitionQuotePayload); err != nil {
+		return err
+	}
+
+	transitionQuoteRequest := &contracts.TransitionQuoteRequest{
+		ID:     transitionQuotePayload.ID,
+		Status: contracts.QuoteStatus(transitionQuotePayload.Status),
+	}
+
+	err := service.TransitionQuote(transitionQuoteRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Quote updated successfully")
+}
+
+func diffQuotes(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	eventID := c.Params("eventID")
+	_, err := uuid.Parse(eventID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid event ID")
+	}
+
+	var diffQuotesPayload payloads.DiffQuotesPayload
+
+	if err := c.QueryParser(&diffQuotesPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(diffQuotesPayload); err != nil {
+		return err
+	}
+
+	diffQuotesRequest := &contracts.DiffQuotesRequest{
+		EventID:     eventID,
+		FromVersion: diffQuotesPayload.FromVersion,
+		ToVersion:   diffQuotesPayload.ToVersion,
+	}
+
+	diff, err := service.DiffQuotes(diffQuotesRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(diff)
+}
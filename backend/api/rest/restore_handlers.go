@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+func restoreFlower(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var restoreFlowerPayload payloads.RestoreFlowerPayload
+
+	if err := c.BodyParser(&restoreFlowerPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(restoreFlowerPayload); err != nil {
+		return err
+	}
+
+	err := service.RestoreFlower(restoreFlowerPayload.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Flower restored successfully")
+}
+
+func restoreProduct(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var restoreProductPayload payloads.RestoreProductPayload
+
+	if err := c.BodyParser(&restoreProductPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(restoreProductPayload); err != nil {
+		return err
+	}
+
+	err := service.RestoreProduct(restoreProductPayload.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Product restored successfully")
+}
+
+func restoreEvent(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var restoreEventPayload payloads.RestoreEventPayload
+
+	if err := c.BodyParser(&restoreEventPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(restoreEventPayload); err != nil {
+		return err
+	}
+
+	err := service.RestoreEvent(restoreEventPayload.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Event restored successfully")
+}
@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// routeDocs documents every route of defineRoutes, a route missing here fails the tests
+var routeDocs = map[string]routeDoc{
+	"POST /login":                    {summary: "Log in and receive a token", body: payloads.LoginPayload{}, response: contracts.LoginResponse{}, public: true},
+	"GET /openapi.json":              {summary: "This document", response: map[string]any{}, public: true},
+	"GET /docs":                      {summary: "Swagger UI rendering this document", contentType: fiber.MIMETextHTML, public: true},
+	"GET /docs/swagger-ui.css":       {summary: "Stylesheet of Swagger UI", contentType: "text/css", public: true},
+	"GET /docs/swagger-ui-bundle.js": {summary: "Script of Swagger UI", contentType: "text/javascript", public: true},
+	"GET /healthz":                   {summary: "Liveness of the server", response: HealthResponse{}, public: true},
+	"GET /readyz": {
+		summary: "Readiness of the server and its database, 503 while not ready", response: ReadinessResponse{}, public: true,
+	},
+
+	"POST /flower":          {summary: "Create a flower", body: payloads.CreateFlowerPayload{}, response: "", status: fiber.StatusCreated},
+	"PUT /flower":           {summary: "Edit a flower", body: payloads.EditFlowerPayload{}, response: ""},
+	"DELETE /flower":        {summary: "Delete a flower", body: payloads.DeleteFlowerPayload{}, response: ""},
+	"POST /flower/restore":  {summary: "Restore a deleted flower", body: payloads.RestoreFlowerPayload{}, response: ""},
+	"GET /flowers":          {summary: "List flowers", query: payloads.GetFilteredFlowersPayload{}, response: []*persistency.Flower{}, paged: true},
+	"GET /flower/:flowerID": {summary: "Get a flower", response: persistency.Flower{}},
+	"PUT /flower/packing-options": {
+		summary: "Set the packing options of a flower at a supplier", body: payloads.SetFlowerPackingOptionsPayload{}, response: "",
+	},
+	"GET /flower/:flowerID/packing-options": {
+		summary: "Get the packing options of a flower", response: []*persistency.FlowerPackageOptions{},
+	},
+
+	"POST /product":           {summary: "Create a product", body: payloads.CreateProductPayload{}, response: "", status: fiber.StatusCreated},
+	"PUT /product":            {summary: "Edit a product", body: payloads.EditProductPayload{}, response: ""},
+	"DELETE /product":         {summary: "Delete a product", body: payloads.DeleteProductPayload{}, response: ""},
+	"POST /product/restore":   {summary: "Restore a deleted product", body: payloads.RestoreProductPayload{}, response: ""},
+	"GET /products":           {summary: "List products", query: payloads.GetFilteredProductsPayload{}, response: []*persistency.Product{}, paged: true},
+	"GET /product/:productID": {summary: "Get a product", response: persistency.Product{}},
+	"POST /product/flowers":   {summary: "Add flowers to a product", body: payloads.AddFlowersToProductPayload{}, response: ""},
+	"PUT /product/flowers":    {summary: "Edit the flowers of a product", body: payloads.AddFlowersToProductPayload{}, response: ""},
+
+	"POST /event":          {summary: "Create an event", body: payloads.CreateEventPayload{}, response: "", status: fiber.StatusCreated},
+	"PUT /event":           {summary: "Edit an event", body: payloads.EditEventPayload{}, response: ""},
+	"DELETE /event":        {summary: "Delete an event", body: payloads.DeleteEventPayload{}, response: ""},
+	"POST /event/restore":  {summary: "Restore a deleted event", body: payloads.RestoreEventPayload{}, response: ""},
+	"GET /events":          {summary: "List events", query: payloads.GetFilteredEventsPayload{}, response: []*persistency.Event{}, paged: true},
+	"GET /event/:eventID":  {summary: "Get an event", response: persistency.Event{}},
+	"POST /event/products": {summary: "Add products to an event", body: payloads.AddProductsToEventPayload{}, response: ""},
+	"PUT /event/products":  {summary: "Edit the products of an event", body: payloads.AddProductsToEventPayload{}, response: ""},
+	"POST /event/:eventID/transition": {
+		summary: "Move an event to another status", body: payloads.TransitionEventPayload{}, response: "",
+	},
+	"GET /event/flowers/:eventID": {
+		summary: "Get the flowers and packages an event needs", query: payloads.GetFlowersInEventPayload{},
+		response: contracts.FlowersPackagesResponse{},
+	},
+	"GET /event/:eventID/quote": {
+		summary: "Price an event", query: payloads.GetEventQuotePayload{}, response: contracts.EventQuoteResponse{},
+	},
+	"GET /event/:eventID/quote.pdf": {
+		summary: "Render the quote of an event", query: payloads.GetEventQuotePayload{}, contentType: "application/pdf",
+	},
+	"GET /event/:eventID/order-sheet.pdf": {
+		summary: "Render the order sheet of an event", query: payloads.GetFlowersInEventPayload{}, contentType: "application/pdf",
+	},
+	"POST /event/purchase-orders": {
+		summary: "Create the purchase orders of an event", body: payloads.CreatePurchaseOrdersPayload{},
+		response: []*persistency.PurchaseOrder{}, status: fiber.StatusCreated,
+	},
+	"POST /event/quotes": {
+		summary: "Create a quote version of an event", body: payloads.CreateQuotePayload{},
+		response: persistency.Quote{}, status: fiber.StatusCreated,
+	},
+	"GET /event/:eventID/quotes": {summary: "List the quote versions of an event", response: []*persistency.Quote{}},
+	"GET /event/:eventID/quotes/diff": {
+		summary: "Compare two quote versions of an event", query: payloads.DiffQuotesPayload{},
+		response: contracts.QuoteDiffResponse{},
+	},
+
+	"GET /quote/:quoteID":    {summary: "Get a quote version", response: persistency.Quote{}},
+	"POST /quote/transition": {summary: "Move a quote version to another status", body: payloads.TransitionQuotePayload{}, response: ""},
+
+	"POST /supplier":            {summary: "Create a supplier", body: payloads.CreateSupplierPayload{}, response: "", status: fiber.StatusCreated},
+	"PUT /supplier":             {summary: "Edit a supplier", body: payloads.EditSupplierPayload{}, response: ""},
+	"DELETE /supplier":          {summary: "Delete a supplier", body: payloads.DeleteSupplierPayload{}, response: ""},
+	"GET /suppliers":            {summary: "List suppliers", query: payloads.GetFilteredSuppliersPayload{}, response: []*persistency.Supplier{}},
+	"GET /supplier/:supplierID": {summary: "Get a supplier", response: persistency.Supplier{}},
+
+	"POST /customer":                   {summary: "Create a customer", body: payloads.CreateCustomerPayload{}, response: "", status: fiber.StatusCreated},
+	"PUT /customer":                    {summary: "Edit a customer", body: payloads.EditCustomerPayload{}, response: ""},
+	"DELETE /customer":                 {summary: "Delete a customer", body: payloads.DeleteCustomerPayload{}, response: ""},
+	"GET /customers":                   {summary: "List customers", query: payloads.GetFilteredCustomersPayload{}, response: []*persistency.Customer{}},
+	"GET /customer/:customerID":        {summary: "Get a customer", response: persistency.Customer{}},
+	"GET /customer/:customerID/events": {summary: "List the events of a customer", response: []*persistency.Event{}},
+
+	"GET /purchase-orders": {
+		summary: "List purchase orders", query: payloads.GetFilteredPurchaseOrdersPayload{}, response: []*persistency.PurchaseOrder{},
+	},
+	"GET /purchase-order/:purchaseOrderID": {summary: "Get a purchase order", response: persistency.PurchaseOrder{}},
+	"POST /purchase-order/transition": {
+		summary: "Move a purchase order to another status", body: payloads.TransitionPurchaseOrderPayload{}, response: "",
+	},
+
+	"POST /stock": {
+		summary: "Record a stock movement", body: payloads.AddStockEntryPayload{},
+		response: []*persistency.StockEntry{}, status: fiber.StatusCreated,
+	},
+	"GET /stock":        {summary: "Get the stock level of every flower", response: []*persistency.StockLevel{}},
+	"GET /stock/ledger": {summary: "List stock movements", query: payloads.GetStockEntriesPayload{}, response: []*persistency.StockEntry{}},
+	"GET /stock/lots":   {summary: "List stock lots", query: payloads.GetStockLotsPayload{}, response: []*persistency.StockLot{}},
+	"GET /stock/lots/expiring": {
+		summary: "List the stock lots expiring before a day", query: payloads.GetExpiringStockLotsPayload{},
+		response: []*persistency.StockLot{},
+	},
+
+	"GET /audit":  {summary: "List audit records", query: payloads.GetAuditRecordsPayload{}, response: []*persistency.AuditRecord{}},
+	"GET /search": {summary: "Search flowers, products and events", query: payloads.SearchPayload{}, response: contracts.SearchResponse{}},
+	"POST /graphql": {
+		summary: "Query events, products and flowers with their relations in one request", body: payloads.GraphQLPayload{},
+		response: map[string]any{},
+	},
+
+	"POST /user":     {summary: "Create a user", body: payloads.CreateUserPayload{}, response: "", status: fiber.StatusCreated},
+	"GET /me":        {summary: "Get the authenticated user", response: persistency.User{}},
+	"GET /users":     {summary: "List users", response: []*persistency.User{}},
+	"PUT /user/role": {summary: "Assign a role to a user", body: payloads.AssignRolePayload{}, response: ""},
+	"POST /api-token": {
+		summary: "Create an API token", body: payloads.CreateAPITokenPayload{},
+		response: contracts.CreateAPITokenResponse{}, status: fiber.StatusCreated,
+	},
+	"GET /api-tokens":   {summary: "List the API tokens of the authenticated user", response: []*persistency.APIToken{}},
+	"DELETE /api-token": {summary: "Revoke an API token", body: payloads.RevokeAPITokenPayload{}, response: ""},
+}
@@ -0,0 +1,35 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+func search(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var searchPayload payloads.SearchPayload
+
+	if err := c.QueryParser(&searchPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(searchPayload); err != nil {
+		return err
+	}
+
+	searchRequest := &contracts.SearchRequest{
+		Query: searchPayload.Query,
+		Limit: searchPayload.Limit,
+	}
+
+	results, err := service.Search(searchRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(results)
+}
@@ -1,8 +1,11 @@
 package rest
 
 import (
+	"context"
+	"flower-management/api/graphql"
 	"flower-management/internal/core/config"
 	"flower-management/internal/core/servicecore"
+	"net"
 	"strconv"
 	"time"
 
@@ -16,6 +19,8 @@ type RestServer struct {
 	app         *fiber.App
 	service     *servicecore.ServiceCore
 	idleTimeout time.Duration
+	lifecycle   *lifecycle
+	errs        chan error
 }
 
 func NewRestServer(cfg *config.RestConfig, service *servicecore.ServiceCore) *RestServer { //nolint:lll
@@ -24,102 +29,335 @@ func NewRestServer(cfg *config.RestConfig, service *servicecore.ServiceCore) *Re
 		sizeLimit:   cfg.SizeLimit,
 		headerSize:  cfg.HeaderSize,
 		idleTimeout: time.Duration(cfg.IdleTimeout) * time.Second,
-		app:         fiber.New(),
-		service:     service,
+		app: fiber.New(fiber.Config{
+			// list filters accept comma separated values
+			EnableSplittingOnParsers: true,
+			ErrorHandler:             errorHandler,
+			// idle keep-alive connections are closed after the idle timeout, so a shutdown does not wait on them
+			IdleTimeout: time.Duration(cfg.IdleTimeout) * time.Second,
+		}),
+		service:   service,
+		lifecycle: newLifecycle(),
+		errs:      make(chan error, 1),
 	}
 }
 
+// Start listens on the port and serves the requests in the background. A port that cannot be listened
+// on is returned, an error stopping the server later on is sent to Errors.
 func (r *RestServer) Start() error {
-	defineRoutes(r.app, r.service)
+	defineRoutes(r.app, r.service, r.lifecycle)
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(r.port))
+	if err != nil {
+		return err
+	}
 
 	go func() {
-		if err := r.app.Listen(":" + strconv.Itoa(r.port)); err != nil {
-			panic(err)
+		if err := r.app.Listener(listener); err != nil {
+			r.errs <- err
 		}
 	}()
 
 	return nil
 }
 
-func defineRoutes(app *fiber.App, service *servicecore.ServiceCore) {
+// MarkReady makes /readyz report the server ready, once everything the process serves has started
+func (r *RestServer) MarkReady() {
+	r.lifecycle.set(lifecycleReady)
+}
+
+// Errors receives the error the server stopped with, it stays empty once the server is shut down
+func (r *RestServer) Errors() <-chan error {
+	return r.errs
+}
+
+// MarkStopping makes /readyz report the server stopping while it still serves requests, so the load
+// balancer takes it out of rotation before the listener is closed
+func (r *RestServer) MarkStopping() {
+	r.lifecycle.set(lifecycleStopping)
+}
+
+// Shutdown stops accepting connections and waits for the requests in flight, the requests still running
+// when ctx is done are cut off
+func (r *RestServer) Shutdown(ctx context.Context) error {
+	r.lifecycle.set(lifecycleStopping)
+	return r.app.ShutdownWithContext(ctx)
+}
+
+func defineRoutes(app *fiber.App, service *servicecore.ServiceCore, lifecycle *lifecycle) {
+	app.Post("/login", func(c *fiber.Ctx) error {
+		return login(c, service)
+	})
+
+	app.Get("/openapi.json", serveOpenAPI(service.AuthConfig.Enabled))
+	app.Get("/docs", serveSwaggerUI)
+	app.Get("/docs/swagger-ui.css", serveSwaggerAsset("swagger-ui.css"))
+	app.Get("/docs/swagger-ui-bundle.js", serveSwaggerAsset("swagger-ui-bundle.js"))
+
+	app.Get("/healthz", healthz)
+	app.Get("/readyz", func(c *fiber.Ctx) error {
+		return readyz(c, service, lifecycle)
+	})
+
+	// every route below requires a token
+	if service.AuthConfig.Enabled {
+		app.Use(authenticate(service))
+	} else {
+		app.Use(checkTenantHeader)
+	}
+
 	app.Post("/flower", func(c *fiber.Ctx) error {
-		return createFlower(c, service)
+		return createFlower(c, requestService(c, service))
 	})
 
 	app.Post("/product", func(c *fiber.Ctx) error {
-		return createProduct(c, service)
+		return createProduct(c, requestService(c, service))
 	})
 
 	app.Post("/event", func(c *fiber.Ctx) error {
-		return createEvent(c, service)
+		return createEvent(c, requestService(c, service))
 	})
 
 	app.Put("/flower", func(c *fiber.Ctx) error {
-		return editFlower(c, service)
+		return editFlower(c, requestService(c, service))
 	})
 
 	app.Put("/product", func(c *fiber.Ctx) error {
-		return editProduct(c, service)
+		return editProduct(c, requestService(c, service))
 	})
 
 	app.Put("/event", func(c *fiber.Ctx) error {
-		return editEvent(c, service)
+		return editEvent(c, requestService(c, service))
 	})
 
 	app.Delete("/flower", func(c *fiber.Ctx) error {
-		return deleteFlower(c, service)
+		return deleteFlower(c, requestService(c, service))
 	})
 
 	app.Delete("/product", func(c *fiber.Ctx) error {
-		return deleteProduct(c, service)
+		return deleteProduct(c, requestService(c, service))
 	})
 
 	app.Delete("/event", func(c *fiber.Ctx) error {
-		return deleteEvent(c, service)
+		return deleteEvent(c, requestService(c, service))
+	})
+
+	app.Post("/flower/restore", func(c *fiber.Ctx) error {
+		return restoreFlower(c, requestService(c, service))
+	})
+
+	app.Post("/product/restore", func(c *fiber.Ctx) error {
+		return restoreProduct(c, requestService(c, service))
+	})
+
+	app.Post("/event/restore", func(c *fiber.Ctx) error {
+		return restoreEvent(c, requestService(c, service))
 	})
 
 	app.Get("/flowers", func(c *fiber.Ctx) error {
-		return getFilteredFlowers(c, service)
+		return getFilteredFlowers(c, requestService(c, service))
 	})
 
 	app.Get("/products", func(c *fiber.Ctx) error {
-		return getFilteredProducts(c, service)
+		return getFilteredProducts(c, requestService(c, service))
 	})
 
 	app.Get("/events", func(c *fiber.Ctx) error {
-		return getFilteredEvents(c, service)
+		return getFilteredEvents(c, requestService(c, service))
 	})
 
 	app.Get("/event/:eventID", func(c *fiber.Ctx) error {
-		return getEvent(c, service)
+		return getEvent(c, requestService(c, service))
 	})
 
 	app.Get("/product/:productID", func(c *fiber.Ctx) error {
-		return getProduct(c, service)
+		return getProduct(c, requestService(c, service))
 	})
 
 	app.Get("/flower/:flowerID", func(c *fiber.Ctx) error {
-		return getFlower(c, service)
+		return getFlower(c, requestService(c, service))
 	})
 
 	app.Post("/product/flowers", func(c *fiber.Ctx) error {
-		return addFlowersToProduct(c, service)
+		return addFlowersToProduct(c, requestService(c, service))
 	})
 
 	app.Post("/event/products", func(c *fiber.Ctx) error {
-		return addProductsToEvent(c, service)
+		return addProductsToEvent(c, requestService(c, service))
 	})
 
 	app.Put("/product/flowers", func(c *fiber.Ctx) error {
-		return editFlowersInProduct(c, service)
+		return editFlowersInProduct(c, requestService(c, service))
 	})
 
 	app.Put("/event/products", func(c *fiber.Ctx) error {
-		return editProductsInEvent(c, service)
+		return editProductsInEvent(c, requestService(c, service))
+	})
+
+	app.Post("/event/:eventID/transition", func(c *fiber.Ctx) error {
+		return transitionEvent(c, requestService(c, service))
 	})
 
 	app.Get("/event/flowers/:eventID", func(c *fiber.Ctx) error {
-		return getFlowersInEvent(c, service)
+		return getFlowersInEvent(c, requestService(c, service))
+	})
+
+	app.Get("/event/:eventID/quote", func(c *fiber.Ctx) error {
+		return getEventQuote(c, requestService(c, service))
+	})
+
+	app.Get("/event/:eventID/quote.pdf", func(c *fiber.Ctx) error {
+		return getEventQuotePDF(c, requestService(c, service))
+	})
+
+	app.Get("/event/:eventID/order-sheet.pdf", func(c *fiber.Ctx) error {
+		return getOrderSheetPDF(c, requestService(c, service))
+	})
+
+	app.Post("/supplier", func(c *fiber.Ctx) error {
+		return createSupplier(c, requestService(c, service))
+	})
+
+	app.Put("/supplier", func(c *fiber.Ctx) error {
+		return editSupplier(c, requestService(c, service))
+	})
+
+	app.Delete("/supplier", func(c *fiber.Ctx) error {
+		return deleteSupplier(c, requestService(c, service))
+	})
+
+	app.Get("/suppliers", func(c *fiber.Ctx) error {
+		return getFilteredSuppliers(c, requestService(c, service))
 	})
 
+	app.Get("/supplier/:supplierID", func(c *fiber.Ctx) error {
+		return getSupplier(c, requestService(c, service))
+	})
+
+	app.Post("/customer", func(c *fiber.Ctx) error {
+		return createCustomer(c, requestService(c, service))
+	})
+
+	app.Put("/customer", func(c *fiber.Ctx) error {
+		return editCustomer(c, requestService(c, service))
+	})
+
+	app.Delete("/customer", func(c *fiber.Ctx) error {
+		return deleteCustomer(c, requestService(c, service))
+	})
+
+	app.Get("/customers", func(c *fiber.Ctx) error {
+		return getFilteredCustomers(c, requestService(c, service))
+	})
+
+	app.Get("/customer/:customerID", func(c *fiber.Ctx) error {
+		return getCustomer(c, requestService(c, service))
+	})
+
+	app.Get("/customer/:customerID/events", func(c *fiber.Ctx) error {
+		return getCustomerEvents(c, requestService(c, service))
+	})
+
+	app.Put("/flower/packing-options", func(c *fiber.Ctx) error {
+		return setFlowerPackingOptions(c, requestService(c, service))
+	})
+
+	app.Get("/flower/:flowerID/packing-options", func(c *fiber.Ctx) error {
+		return getFlowerPackingOptions(c, requestService(c, service))
+	})
+
+	app.Post("/event/purchase-orders", func(c *fiber.Ctx) error {
+		return createPurchaseOrders(c, requestService(c, service))
+	})
+
+	app.Get("/purchase-orders", func(c *fiber.Ctx) error {
+		return getFilteredPurchaseOrders(c, requestService(c, service))
+	})
+
+	app.Get("/purchase-order/:purchaseOrderID", func(c *fiber.Ctx) error {
+		return getPurchaseOrder(c, requestService(c, service))
+	})
+
+	app.Post("/purchase-order/transition", func(c *fiber.Ctx) error {
+		return transitionPurchaseOrder(c, requestService(c, service))
+	})
+
+	app.Post("/event/quotes", func(c *fiber.Ctx) error {
+		return createQuoteVersion(c, requestService(c, service))
+	})
+
+	app.Get("/event/:eventID/quotes", func(c *fiber.Ctx) error {
+		return getEventQuotes(c, requestService(c, service))
+	})
+
+	app.Get("/event/:eventID/quotes/diff", func(c *fiber.Ctx) error {
+		return diffQuotes(c, requestService(c, service))
+	})
+
+	app.Get("/quote/:quoteID", func(c *fiber.Ctx) error {
+		return getQuote(c, requestService(c, service))
+	})
+
+	app.Post("/quote/transition", func(c *fiber.Ctx) error {
+		return transitionQuote(c, requestService(c, service))
+	})
+
+	app.Post("/stock", func(c *fiber.Ctx) error {
+		return addStockEntry(c, requestService(c, service))
+	})
+
+	app.Get("/stock", func(c *fiber.Ctx) error {
+		return getStockLevels(c, requestService(c, service))
+	})
+
+	app.Get("/stock/ledger", func(c *fiber.Ctx) error {
+		return getStockEntries(c, requestService(c, service))
+	})
+
+	app.Get("/stock/lots", func(c *fiber.Ctx) error {
+		return getStockLots(c, requestService(c, service))
+	})
+
+	app.Get("/stock/lots/expiring", func(c *fiber.Ctx) error {
+		return getExpiringStockLots(c, requestService(c, service))
+	})
+
+	app.Get("/audit", func(c *fiber.Ctx) error {
+		return getAuditRecords(c, requestService(c, service))
+	})
+
+	app.Get("/search", func(c *fiber.Ctx) error {
+		return search(c, requestService(c, service))
+	})
+
+	graphQLSchema := graphql.NewSchema()
+	app.Post("/graphql", func(c *fiber.Ctx) error {
+		return executeGraphQL(c, graphQLSchema, requestService(c, service))
+	})
+
+	app.Post("/user", func(c *fiber.Ctx) error {
+		return createUser(c, requestService(c, service))
+	})
+
+	app.Get("/me", getMe)
+
+	app.Get("/users", func(c *fiber.Ctx) error {
+		return getUsers(c, requestService(c, service))
+	})
+
+	app.Put("/user/role", func(c *fiber.Ctx) error {
+		return assignRole(c, requestService(c, service))
+	})
+
+	app.Post("/api-token", func(c *fiber.Ctx) error {
+		return createAPIToken(c, requestService(c, service))
+	})
+
+	app.Get("/api-tokens", func(c *fiber.Ctx) error {
+		return getAPITokens(c, requestService(c, service))
+	})
+
+	app.Delete("/api-token", func(c *fiber.Ctx) error {
+		return revokeAPIToken(c, requestService(c, service))
+	})
 }
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"flower-management/internal/core/config"
+	"flower-management/internal/core/servicecore/servicecoretest"
+	"flower-management/internal/persistency/mock"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func freePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestShutdownDrainsRequestsInFlight(t *testing.T) {
+	service := servicecoretest.New(mock.NewDalMock())
+	port := freePort(t)
+	server := NewRestServer(&config.RestConfig{Port: port}, service)
+
+	started := make(chan struct{})
+	server.app.Get("/slow", func(c *fiber.Ctx) error {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return c.SendString("done")
+	})
+	if err := server.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewRestServer(&config.RestConfig{Port: port}, service).Start(); err == nil {
+		t.Errorf("a port in use should be returned as an error")
+	}
+
+	type result struct {
+		body string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		response, err := http.Get("http://localhost:" + strconv.Itoa(port) + "/slow")
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		results <- result{body: string(body), err: err}
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if result := <-results; result.err != nil || result.body != "done" {
+		t.Errorf("the request in flight should be answered, got %q, %v", result.body, result.err)
+	}
+	select {
+	case err := <-server.Errors():
+		t.Errorf("a shut down server should not report an error, got %v", err)
+	default:
+	}
+}
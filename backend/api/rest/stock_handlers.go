@@ -0,0 +1,130 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+func addStockEntry(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var addStockEntryPayload payloads.AddStockEntryPayload
+
+	if err := c.BodyParser(&addStockEntryPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(addStockEntryPayload); err != nil {
+		return err
+	}
+
+	addStockEntryRequest := &contracts.AddStockEntryRequest{
+		FlowerID:   addStockEntryPayload.FlowerID,
+		EntryType:  contracts.StockEntryType(addStockEntryPayload.EntryType),
+		Quantity:   addStockEntryPayload.Quantity,
+		EventID:    addStockEntryPayload.EventID,
+		LotID:      addStockEntryPayload.LotID,
+		ReceivedAt: addStockEntryPayload.ReceivedAt,
+		Note:       addStockEntryPayload.Note,
+	}
+
+	entries, err := service.AddStockEntry(addStockEntryRequest)
+	if err != nil {
+		return err
+	}
+
+	c.Status(fiber.StatusCreated)
+	return c.JSON(entries)
+}
+
+func getStockLevels(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	levels, err := service.GetStockLevels()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(levels)
+}
+
+func getStockEntries(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getStockEntriesPayload payloads.GetStockEntriesPayload
+
+	if err := c.QueryParser(&getStockEntriesPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getStockEntriesPayload); err != nil {
+		return err
+	}
+
+	getStockEntriesRequest := &contracts.GetStockEntriesRequest{
+		FlowerID:  getStockEntriesPayload.FlowerID,
+		EventID:   getStockEntriesPayload.EventID,
+		LotID:     getStockEntriesPayload.LotID,
+		EntryType: contracts.StockEntryType(getStockEntriesPayload.EntryType),
+	}
+
+	entries, err := service.GetStockEntries(getStockEntriesRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(entries)
+}
+
+func getStockLots(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getStockLotsPayload payloads.GetStockLotsPayload
+
+	if err := c.QueryParser(&getStockLotsPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getStockLotsPayload); err != nil {
+		return err
+	}
+
+	getStockLotsRequest := &contracts.GetStockLotsRequest{
+		OnlyAvailable: getStockLotsPayload.OnlyAvailable,
+	}
+	if getStockLotsPayload.FlowerID != "" {
+		getStockLotsRequest.FlowerIDs = []string{getStockLotsPayload.FlowerID}
+	}
+
+	lots, err := service.GetStockLots(getStockLotsRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(lots)
+}
+
+func getExpiringStockLots(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getExpiringStockLotsPayload payloads.GetExpiringStockLotsPayload
+
+	if err := c.QueryParser(&getExpiringStockLotsPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getExpiringStockLotsPayload); err != nil {
+		return err
+	}
+
+	before, err := time.Parse(time.DateOnly, getExpiringStockLotsPayload.Before)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	lots, err := service.GetExpiringStockLots(before)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(lots)
+}
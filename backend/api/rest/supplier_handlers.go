@@ -0,0 +1,167 @@
+package rest
+
+import (
+	"flower-management/api/rest/payloads"
+	"flower-management/contracts"
+	"flower-management/internal/core/servicecore"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func createSupplier(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var createSupplierPayload payloads.CreateSupplierPayload
+
+	if err := c.BodyParser(&createSupplierPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(createSupplierPayload); err != nil {
+		return err
+	}
+
+	createSupplierRequest := &contracts.CreateSupplierRequest{
+		Name:    createSupplierPayload.Name,
+		Phone:   createSupplierPayload.Phone,
+		Email:   createSupplierPayload.Email,
+		Address: createSupplierPayload.Address,
+	}
+
+	supplierID, err := service.CreateSupplier(createSupplierRequest)
+	if err != nil {
+		return err
+	}
+
+	c.Status(fiber.StatusCreated)
+	return c.SendString(supplierID)
+}
+
+func editSupplier(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var editSupplierPayload payloads.EditSupplierPayload
+
+	if err := c.BodyParser(&editSupplierPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(editSupplierPayload); err != nil {
+		return err
+	}
+
+	editSupplierRequest := &contracts.EditSupplierRequest{
+		ID:      editSupplierPayload.ID,
+		Name:    editSupplierPayload.Name,
+		Phone:   editSupplierPayload.Phone,
+		Email:   editSupplierPayload.Email,
+		Address: editSupplierPayload.Address,
+	}
+
+	err := service.EditSupplier(editSupplierRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Supplier updated successfully")
+}
+
+func deleteSupplier(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var deleteSupplierPayload payloads.DeleteSupplierPayload
+
+	if err := c.BodyParser(&deleteSupplierPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(deleteSupplierPayload); err != nil {
+		return err
+	}
+
+	err := service.DeleteSupplier(deleteSupplierPayload.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Supplier deleted successfully")
+}
+
+func getFilteredSuppliers(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var getFilteredSuppliersPayload payloads.GetFilteredSuppliersPayload
+
+	if err := c.QueryParser(&getFilteredSuppliersPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(getFilteredSuppliersPayload); err != nil {
+		return err
+	}
+
+	getFilteredSuppliersRequest := &contracts.GetFilteredSuppliersRequest{
+		Name: getFilteredSuppliersPayload.Name,
+	}
+
+	suppliers, err := service.GetFilteredSuppliers(getFilteredSuppliersRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(suppliers)
+}
+
+func getSupplier(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	supplierID := c.Params("supplierID")
+	_, err := uuid.Parse(supplierID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid supplier ID")
+	}
+
+	supplier, err := service.GetSupplier(supplierID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(supplier)
+}
+
+func setFlowerPackingOptions(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	var setFlowerPackingOptionsPayload payloads.SetFlowerPackingOptionsPayload
+
+	if err := c.BodyParser(&setFlowerPackingOptionsPayload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(setFlowerPackingOptionsPayload); err != nil {
+		return err
+	}
+
+	setFlowerPackingOptionsRequest := &contracts.SetFlowerPackingOptionsRequest{
+		FlowerID:       setFlowerPackingOptionsPayload.FlowerID,
+		SupplierID:     setFlowerPackingOptionsPayload.SupplierID,
+		PackingOptions: &setFlowerPackingOptionsPayload.PackingOptions,
+	}
+
+	err := service.SetFlowerPackingOptions(setFlowerPackingOptionsRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString("Packing options updated successfully")
+}
+
+func getFlowerPackingOptions(c *fiber.Ctx, service *servicecore.ServiceCore) error {
+	flowerID := c.Params("flowerID")
+	_, err := uuid.Parse(flowerID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid flower ID")
+	}
+
+	packingOptions, err := service.GetFlowerPackingOptions(flowerID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(packingOptions)
+}
@@ -0,0 +1,21 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// tenantHeader names the tenant of the request while authentication is disabled, authenticated
+// requests always act in the tenant of their user
+const tenantHeader = "X-Tenant-ID"
+
+// checkTenantHeader rejects requests whose tenant header is not a tenant ID
+func checkTenantHeader(c *fiber.Ctx) error {
+	if tenantID := c.Get(tenantHeader); tenantID != "" {
+		if _, err := uuid.Parse(tenantID); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid "+tenantHeader+" header")
+		}
+	}
+
+	return c.Next()
+}
@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	persistency "flower-management/internal/persistency/contracts"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestRequestsWithoutTenantActInTheDefaultTenant(t *testing.T) {
+	app := newTestApp(false)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/product", strings.NewReader(`{"Name": "Bouquet"}`))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := app.Test(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	productID, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	if response.StatusCode != fiber.StatusCreated {
+		t.Fatalf("a request naming no tenant should act in the default tenant, got status %d: %s", response.StatusCode, productID)
+	}
+
+	tests := []struct {
+		name       string
+		tenantID   string
+		wantStatus int
+	}{
+		{name: "default tenant", tenantID: persistency.DefaultTenantID, wantStatus: fiber.StatusOK},
+		{name: "another tenant", tenantID: "00000000-0000-0000-0000-000000000002", wantStatus: fiber.StatusNotFound},
+	}
+	for _, tt := range tests {
+		request := httptest.NewRequest(fiber.MethodGet, "/product/"+string(productID), nil)
+		request.Header.Set(tenantHeader, tt.tenantID)
+		response, err := app.Test(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if response.StatusCode != tt.wantStatus {
+			t.Errorf("%s: got status %d, want %d", tt.name, response.StatusCode, tt.wantStatus)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package rpc
+
+import (
+	"context"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The metadata read by the authenticator, they match the headers of the REST server
+const (
+	authorizationKey = "authorization"
+	tenantKey        = "x-tenant-id"
+	actorKey         = "x-actor"
+)
+
+// reflectionPrefix starts the methods of the reflection service, they are served without a token
+const reflectionPrefix = "/grpc.reflection."
+
+type serviceKey struct{}
+
+// authenticator puts the ServiceCore acting for the caller in the context of every call, like the
+// authenticate middleware and requestService of the REST server
+type authenticator struct {
+	service *servicecore.ServiceCore
+}
+
+func (a *authenticator) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *authenticator) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if strings.HasPrefix(info.FullMethod, reflectionPrefix) {
+		return handler(srv, ss)
+	}
+
+	ctx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+func (a *authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if !a.service.AuthConfig.Enabled {
+		// calls naming no tenant act in the default tenant, like the REST server
+		tenantID := first(md, tenantKey)
+		if tenantID == "" {
+			tenantID = persistency.DefaultTenantID
+		} else if _, err := uuid.Parse(tenantID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s metadata", tenantKey)
+		}
+
+		return context.WithValue(ctx, serviceKey{}, a.service.WithActor(first(md, actorKey)).WithTenant(tenantID)), nil
+	}
+
+	token, found := strings.CutPrefix(first(md, authorizationKey), "Bearer ")
+	if !found || token == "" {
+		return nil, status.Error(codes.Unauthenticated, servicecore.ErrUnauthenticated.Error())
+	}
+
+	user, err := a.service.Authenticate(token)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return context.WithValue(ctx, serviceKey{}, a.service.WithUser(user)), nil
+}
+
+// requestService returns the ServiceCore the authenticator chose for the call
+func requestService(ctx context.Context) *servicecore.ServiceCore {
+	return ctx.Value(serviceKey{}).(*servicecore.ServiceCore)
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// serverStream replaces the context of a stream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
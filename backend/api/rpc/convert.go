@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"flower-management/api/rpc/pb"
+	"flower-management/contracts"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The values accepted by the string enums of the requests, empty values pick the default of the service
+var (
+	matchModes        = []string{"contains", "prefix", "exact"}
+	packingStrategies = []string{"cheapest", "least-waste", "fewest-packages", "cheapest-within-waste"}
+	sourcingModes     = []string{"cheapest-supplier", "split-suppliers"}
+	eventStatuses     = []string{"inquiry", "quoted", "confirmed", "in-production", "delivered", "closed", "cancelled"}
+)
+
+// maxPageSize matches the limit the REST list endpoints accept
+const maxPageSize = 500
+
+// checkRequired rejects a request missing a required field
+func checkRequired(name string, value string) error {
+	if value == "" {
+		return status.Errorf(codes.InvalidArgument, "%s is required", name)
+	}
+	return nil
+}
+
+// checkOneOf rejects a request whose enum field holds an unknown value
+func checkOneOf(name string, value string, allowed []string) error {
+	if value != "" && !slices.Contains(allowed, value) {
+		return status.Errorf(codes.InvalidArgument, "%s must be one of %s", name, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
+// checkIDs rejects a request whose list of IDs holds something else than a UUID
+func checkIDs(name string, ids []string) error {
+	for _, id := range ids {
+		if err := checkID(name, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pageRequest converts the page of a list request, the cursor is advanced by the list RPCs while they
+// stream the pages
+func pageRequest(page *pb.Page) (contracts.PageRequest, error) {
+	if page.GetSize() < 0 || page.GetSize() > maxPageSize {
+		return contracts.PageRequest{}, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+	}
+
+	pageRequest := contracts.PageRequest{Limit: int(page.GetSize())}
+	for _, field := range strings.Split(page.GetSort(), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		pageRequest.Sort = append(pageRequest.Sort, contracts.SortField{
+			Field:      strings.TrimPrefix(field, "-"),
+			Descending: strings.HasPrefix(field, "-"),
+		})
+	}
+
+	return pageRequest, nil
+}
+
+// timestamp converts an optional time, nil stays unset
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timeOf converts an optional timestamp, unset becomes the zero time
+func timeOf(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"errors"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusError answers an error of the service with the code matching its kind, like the error
+// handler of the REST server
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, persistency.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, persistency.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, persistency.ErrValidation), errors.Is(err, persistency.ErrForeignKeyViolation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, servicecore.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, servicecore.ErrUnauthenticated), errors.Is(err, servicecore.ErrInvalidCredentials):
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// the detail of an unexpected error can hold SQL or other internals of the server, it is only logged
+	log.Printf("internal error: %v", err)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// checkID rejects a request whose ID is not a UUID
+func checkID(name string, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s", name)
+	}
+	return nil
+}
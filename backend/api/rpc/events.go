@@ -0,0 +1,224 @@
+package rpc
+
+import (
+	"context"
+	"flower-management/api/rpc/pb"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type eventsServer struct {
+	pb.UnimplementedEventsServer
+}
+
+func (s *eventsServer) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.CreateResponse, error) {
+	if err := checkRequired("name", req.GetName()); err != nil {
+		return nil, err
+	}
+	if req.GetDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+	if err := checkRequired("address", req.GetAddress()); err != nil {
+		return nil, err
+	}
+	if err := checkRequired("description", req.GetDescription()); err != nil {
+		return nil, err
+	}
+	if req.GetCustomerId() != "" {
+		if err := checkID("customer_id", req.GetCustomerId()); err != nil {
+			return nil, err
+		}
+	}
+
+	createEventRequest := &contracts.CreateEventRequest{
+		Name:        req.GetName(),
+		Date:        req.GetDate().AsTime(),
+		CustomerID:  req.GetCustomerId(),
+		Phone:       req.GetPhone(),
+		Email:       req.GetEmail(),
+		Address:     req.GetAddress(),
+		Description: req.GetDescription(),
+	}
+
+	eventID, err := requestService(ctx).CreateEvent(createEventRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CreateResponse{Id: eventID}, nil
+}
+
+func (s *eventsServer) GetEvent(ctx context.Context, req *pb.GetRequest) (*pb.Event, error) {
+	if err := checkID("event ID", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	event, err := requestService(ctx).GetEvent(req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if event == nil {
+		return nil, status.Errorf(codes.NotFound, "event with ID %s does not exist", req.GetId())
+	}
+
+	return eventMessage(event), nil
+}
+
+func (s *eventsServer) ListEvents(req *pb.ListEventsRequest, stream grpc.ServerStreamingServer[pb.Event]) error {
+	if err := checkIDs("event ID", req.GetIds()); err != nil {
+		return err
+	}
+	if err := checkIDs("customer ID", req.GetCustomerIds()); err != nil {
+		return err
+	}
+	if err := checkOneOf("match", req.GetMatch(), matchModes); err != nil {
+		return err
+	}
+	statuses := make([]contracts.EventStatus, 0, len(req.GetStatuses()))
+	for _, eventStatus := range req.GetStatuses() {
+		if err := checkOneOf("status", eventStatus, eventStatuses); err != nil {
+			return err
+		}
+		statuses = append(statuses, contracts.EventStatus(eventStatus))
+	}
+	page, err := pageRequest(req.GetPage())
+	if err != nil {
+		return err
+	}
+
+	service := requestService(stream.Context())
+	getFilteredEventsRequest := &contracts.GetFilteredEventsRequest{
+		IDs:            req.GetIds(),
+		Name:           req.GetName(),
+		Address:        req.GetAddress(),
+		Description:    req.GetDescription(),
+		Match:          contracts.MatchMode(req.GetMatch()),
+		Date:           timeOf(req.GetDate()),
+		DateFrom:       timeOf(req.GetFrom()),
+		DateTo:         timeOf(req.GetTo()),
+		CustomerIDs:    req.GetCustomerIds(),
+		Statuses:       statuses,
+		IncludeDeleted: req.GetIncludeDeleted(),
+		Page:           page,
+	}
+
+	for {
+		events, nextPage, err := service.GetFilteredEvents(getFilteredEventsRequest)
+		if err != nil {
+			return statusError(err)
+		}
+
+		for _, event := range events {
+			if err := stream.Send(eventMessage(event)); err != nil {
+				return err
+			}
+		}
+
+		if nextPage.NextCursor == "" {
+			return nil
+		}
+		getFilteredEventsRequest.Page.Cursor = nextPage.NextCursor
+	}
+}
+
+func (s *eventsServer) AddProductsToEvent(ctx context.Context, req *pb.AddProductsToEventRequest) (*pb.Empty, error) {
+	if err := checkID("event_id", req.GetEventId()); err != nil {
+		return nil, err
+	}
+
+	products := make([]contracts.ProductInEvent, 0, len(req.GetProducts()))
+	for _, product := range req.GetProducts() {
+		if err := checkID("product_id", product.GetProductId()); err != nil {
+			return nil, err
+		}
+		products = append(products, contracts.ProductInEvent{
+			ProductID: product.GetProductId(),
+			Quantity:  int(product.GetQuantity()),
+		})
+	}
+
+	addProductsToEventRequest := &contracts.AddProductsToEventRequest{
+		EventID:  req.GetEventId(),
+		Products: &products,
+	}
+
+	if err := requestService(ctx).AddProductsToEvent(addProductsToEventRequest); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *eventsServer) GetEventFlowers(ctx context.Context, req *pb.GetEventFlowersRequest) (*pb.EventFlowersResponse, error) {
+	if err := checkID("event_id", req.GetEventId()); err != nil {
+		return nil, err
+	}
+	if err := checkOneOf("strategy", req.GetStrategy(), packingStrategies); err != nil {
+		return nil, err
+	}
+	if err := checkOneOf("sourcing", req.GetSourcing(), sourcingModes); err != nil {
+		return nil, err
+	}
+	if req.GetMaxWastePercent() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_waste_percent must not be negative")
+	}
+
+	getFlowersInEventRequest := &contracts.GetFlowersInEventRequest{
+		EventID:         req.GetEventId(),
+		Strategy:        contracts.PackingStrategy(req.GetStrategy()),
+		MaxWastePercent: req.GetMaxWastePercent(),
+		Sourcing:        contracts.SourcingMode(req.GetSourcing()),
+	}
+
+	flowers, err := requestService(ctx).GetFlowersInEvent(getFlowersInEventRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	response := &pb.EventFlowersResponse{
+		Strategy:               string(flowers.Strategy),
+		MaxWastePercent:        flowers.MaxWastePercent,
+		Sourcing:               string(flowers.Sourcing),
+		TotalPrice:             flowers.TotalPrice,
+		TotalPackages:          int32(flowers.TotalPackages),
+		TotalFlowersRequired:   int32(flowers.TotalFlowersRequired),
+		TotalFlowersFromStock:  int32(flowers.TotalFlowersFromStock),
+		TotalFlowersOverbought: int32(flowers.TotalFlowersOverbought),
+	}
+	for _, packages := range flowers.Packages {
+		response.Packages = append(response.Packages, &pb.FlowerPackages{
+			FlowerId:               packages.FlowerID,
+			FlowerName:             packages.FlowerName,
+			SupplierId:             packages.SupplierID,
+			SupplierName:           packages.SupplierName,
+			NumOfFlowersInPackage:  int32(packages.NumOfFlowersInPackage),
+			NumOfPackages:          int32(packages.NumOfPackages),
+			Price:                  packages.Price,
+			NumOfFlowersRequired:   int32(packages.NumOfFlowersRequired),
+			NumOfFlowersFromStock:  int32(packages.NumOfFlowersFromStock),
+			NumOfFlowersOverbought: int32(packages.NumOfFlowersOverbought),
+		})
+	}
+
+	return response, nil
+}
+
+func eventMessage(event *persistency.Event) *pb.Event {
+	return &pb.Event{
+		Id:          event.ID,
+		Name:        event.Name,
+		Date:        timestamppb.New(event.Date),
+		Status:      string(event.Status),
+		CustomerId:  event.CustomerID,
+		Phone:       event.Phone,
+		Email:       event.Email,
+		Address:     event.Address,
+		Description: event.Description,
+		DeletedAt:   timestamp(event.DeletedAt),
+	}
+}
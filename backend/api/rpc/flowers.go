@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"context"
+	"flower-management/api/rpc/pb"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type flowersServer struct {
+	pb.UnimplementedFlowersServer
+}
+
+func (s *flowersServer) CreateFlower(ctx context.Context, req *pb.CreateFlowerRequest) (*pb.CreateResponse, error) {
+	if err := checkRequired("name", req.GetName()); err != nil {
+		return nil, err
+	}
+	if req.GetShelfLifeDays() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "shelf_life_days must not be negative")
+	}
+	if len(req.GetPackingOptions()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packing_options are required")
+	}
+
+	packingOptions := make([]contracts.PackingOptions, 0, len(req.GetPackingOptions()))
+	for _, packingOption := range req.GetPackingOptions() {
+		if packingOption.GetQuantity() <= 0 || packingOption.GetPrice() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "packing options need a positive quantity and price")
+		}
+		if packingOption.GetSupplierId() != "" {
+			if err := checkID("supplier_id", packingOption.GetSupplierId()); err != nil {
+				return nil, err
+			}
+		}
+		packingOptions = append(packingOptions, contracts.PackingOptions{
+			Quantity:   int(packingOption.GetQuantity()),
+			Price:      packingOption.GetPrice(),
+			SupplierID: packingOption.GetSupplierId(),
+		})
+	}
+
+	createFlowerRequest := &contracts.CreateFlowerRequest{
+		Name:           req.GetName(),
+		ShelfLifeDays:  int(req.GetShelfLifeDays()),
+		PackingOptions: &packingOptions,
+	}
+
+	flowerID, err := requestService(ctx).CreateFlower(createFlowerRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CreateResponse{Id: flowerID}, nil
+}
+
+func (s *flowersServer) GetFlower(ctx context.Context, req *pb.GetRequest) (*pb.Flower, error) {
+	if err := checkID("flower ID", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	flower, err := requestService(ctx).GetFlower(req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if flower == nil {
+		return nil, status.Errorf(codes.NotFound, "flower with ID %s does not exist", req.GetId())
+	}
+
+	return flowerMessage(flower), nil
+}
+
+func (s *flowersServer) ListFlowers(req *pb.ListFlowersRequest, stream grpc.ServerStreamingServer[pb.Flower]) error {
+	if err := checkIDs("flower ID", req.GetIds()); err != nil {
+		return err
+	}
+	if err := checkOneOf("match", req.GetMatch(), matchModes); err != nil {
+		return err
+	}
+	page, err := pageRequest(req.GetPage())
+	if err != nil {
+		return err
+	}
+
+	service := requestService(stream.Context())
+	getFilteredFlowersRequest := &contracts.GetFilteredFlowersRequest{
+		IDs:            req.GetIds(),
+		Name:           req.GetName(),
+		Match:          contracts.MatchMode(req.GetMatch()),
+		IncludeDeleted: req.GetIncludeDeleted(),
+		Page:           page,
+	}
+
+	for {
+		flowers, nextPage, err := service.GetFilteredFlowers(getFilteredFlowersRequest)
+		if err != nil {
+			return statusError(err)
+		}
+
+		for _, flower := range flowers {
+			if err := stream.Send(flowerMessage(flower)); err != nil {
+				return err
+			}
+		}
+
+		if nextPage.NextCursor == "" {
+			return nil
+		}
+		getFilteredFlowersRequest.Page.Cursor = nextPage.NextCursor
+	}
+}
+
+func (s *flowersServer) GetPackingOptions(ctx context.Context, req *pb.GetRequest) (*pb.PackingOptionsResponse, error) {
+	if err := checkID("flower ID", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	packingOptions, err := requestService(ctx).GetFlowerPackingOptions(req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	response := &pb.PackingOptionsResponse{}
+	for _, packingOption := range packingOptions {
+		response.PackingOptions = append(response.PackingOptions, &pb.FlowerPackingOption{
+			FlowerId:     packingOption.FlowerID,
+			SupplierId:   packingOption.SupplierID,
+			NumOfFlowers: int32(packingOption.NumOfFlowers),
+			Price:        packingOption.Price,
+		})
+	}
+
+	return response, nil
+}
+
+func flowerMessage(flower *persistency.Flower) *pb.Flower {
+	return &pb.Flower{
+		Id:            flower.ID,
+		Name:          flower.Name,
+		ShelfLifeDays: int32(flower.ShelfLifeDays),
+		DeletedAt:     timestamp(flower.DeletedAt),
+	}
+}
@@ -0,0 +1,737 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: flowermanagement.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Flowers_CreateFlower_FullMethodName      = "/flowermanagement.v1.Flowers/CreateFlower"
+	Flowers_GetFlower_FullMethodName         = "/flowermanagement.v1.Flowers/GetFlower"
+	Flowers_ListFlowers_FullMethodName       = "/flowermanagement.v1.Flowers/ListFlowers"
+	Flowers_GetPackingOptions_FullMethodName = "/flowermanagement.v1.Flowers/GetPackingOptions"
+)
+
+// FlowersClient is the client API for Flowers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Flowers manages the flowers of the catalog and their packing options.
+type FlowersClient interface {
+	CreateFlower(ctx context.Context, in *CreateFlowerRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	GetFlower(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Flower, error)
+	// ListFlowers streams every flower matching the filters, page by page.
+	ListFlowers(ctx context.Context, in *ListFlowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Flower], error)
+	GetPackingOptions(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PackingOptionsResponse, error)
+}
+
+type flowersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlowersClient(cc grpc.ClientConnInterface) FlowersClient {
+	return &flowersClient{cc}
+}
+
+func (c *flowersClient) CreateFlower(ctx context.Context, in *CreateFlowerRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, Flowers_CreateFlower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowersClient) GetFlower(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Flower, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Flower)
+	err := c.cc.Invoke(ctx, Flowers_GetFlower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowersClient) ListFlowers(ctx context.Context, in *ListFlowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Flower], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Flowers_ServiceDesc.Streams[0], Flowers_ListFlowers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListFlowersRequest, Flower]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Flowers_ListFlowersClient = grpc.ServerStreamingClient[Flower]
+
+func (c *flowersClient) GetPackingOptions(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PackingOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackingOptionsResponse)
+	err := c.cc.Invoke(ctx, Flowers_GetPackingOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FlowersServer is the server API for Flowers service.
+// All implementations must embed UnimplementedFlowersServer
+// for forward compatibility.
+//
+// Flowers manages the flowers of the catalog and their packing options.
+type FlowersServer interface {
+	CreateFlower(context.Context, *CreateFlowerRequest) (*CreateResponse, error)
+	GetFlower(context.Context, *GetRequest) (*Flower, error)
+	// ListFlowers streams every flower matching the filters, page by page.
+	ListFlowers(*ListFlowersRequest, grpc.ServerStreamingServer[Flower]) error
+	GetPackingOptions(context.Context, *GetRequest) (*PackingOptionsResponse, error)
+	mustEmbedUnimplementedFlowersServer()
+}
+
+// UnimplementedFlowersServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFlowersServer struct{}
+
+func (UnimplementedFlowersServer) CreateFlower(context.Context, *CreateFlowerRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlower not implemented")
+}
+func (UnimplementedFlowersServer) GetFlower(context.Context, *GetRequest) (*Flower, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlower not implemented")
+}
+func (UnimplementedFlowersServer) ListFlowers(*ListFlowersRequest, grpc.ServerStreamingServer[Flower]) error {
+	return status.Errorf(codes.Unimplemented, "method ListFlowers not implemented")
+}
+func (UnimplementedFlowersServer) GetPackingOptions(context.Context, *GetRequest) (*PackingOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackingOptions not implemented")
+}
+func (UnimplementedFlowersServer) mustEmbedUnimplementedFlowersServer() {}
+func (UnimplementedFlowersServer) testEmbeddedByValue()                 {}
+
+// UnsafeFlowersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlowersServer will
+// result in compilation errors.
+type UnsafeFlowersServer interface {
+	mustEmbedUnimplementedFlowersServer()
+}
+
+func RegisterFlowersServer(s grpc.ServiceRegistrar, srv FlowersServer) {
+	// If the following call pancis, it indicates UnimplementedFlowersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Flowers_ServiceDesc, srv)
+}
+
+func _Flowers_CreateFlower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowersServer).CreateFlower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flowers_CreateFlower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowersServer).CreateFlower(ctx, req.(*CreateFlowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flowers_GetFlower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowersServer).GetFlower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flowers_GetFlower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowersServer).GetFlower(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flowers_ListFlowers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFlowersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlowersServer).ListFlowers(m, &grpc.GenericServerStream[ListFlowersRequest, Flower]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Flowers_ListFlowersServer = grpc.ServerStreamingServer[Flower]
+
+func _Flowers_GetPackingOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowersServer).GetPackingOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flowers_GetPackingOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowersServer).GetPackingOptions(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Flowers_ServiceDesc is the grpc.ServiceDesc for Flowers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Flowers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flowermanagement.v1.Flowers",
+	HandlerType: (*FlowersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFlower",
+			Handler:    _Flowers_CreateFlower_Handler,
+		},
+		{
+			MethodName: "GetFlower",
+			Handler:    _Flowers_GetFlower_Handler,
+		},
+		{
+			MethodName: "GetPackingOptions",
+			Handler:    _Flowers_GetPackingOptions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListFlowers",
+			Handler:       _Flowers_ListFlowers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "flowermanagement.proto",
+}
+
+const (
+	Products_CreateProduct_FullMethodName       = "/flowermanagement.v1.Products/CreateProduct"
+	Products_GetProduct_FullMethodName          = "/flowermanagement.v1.Products/GetProduct"
+	Products_ListProducts_FullMethodName        = "/flowermanagement.v1.Products/ListProducts"
+	Products_AddFlowersToProduct_FullMethodName = "/flowermanagement.v1.Products/AddFlowersToProduct"
+)
+
+// ProductsClient is the client API for Products service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Products manages the products of the catalog and the flowers they are made of.
+type ProductsClient interface {
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	GetProduct(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Product, error)
+	// ListProducts streams every product matching the filters, page by page.
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Product], error)
+	AddFlowersToProduct(ctx context.Context, in *AddFlowersToProductRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type productsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductsClient(cc grpc.ClientConnInterface) ProductsClient {
+	return &productsClient{cc}
+}
+
+func (c *productsClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, Products_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetProduct(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, Products_GetProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Product], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Products_ServiceDesc.Streams[0], Products_ListProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListProductsRequest, Product]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Products_ListProductsClient = grpc.ServerStreamingClient[Product]
+
+func (c *productsClient) AddFlowersToProduct(ctx context.Context, in *AddFlowersToProductRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Products_AddFlowersToProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductsServer is the server API for Products service.
+// All implementations must embed UnimplementedProductsServer
+// for forward compatibility.
+//
+// Products manages the products of the catalog and the flowers they are made of.
+type ProductsServer interface {
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateResponse, error)
+	GetProduct(context.Context, *GetRequest) (*Product, error)
+	// ListProducts streams every product matching the filters, page by page.
+	ListProducts(*ListProductsRequest, grpc.ServerStreamingServer[Product]) error
+	AddFlowersToProduct(context.Context, *AddFlowersToProductRequest) (*Empty, error)
+	mustEmbedUnimplementedProductsServer()
+}
+
+// UnimplementedProductsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductsServer struct{}
+
+func (UnimplementedProductsServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductsServer) GetProduct(context.Context, *GetRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductsServer) ListProducts(*ListProductsRequest, grpc.ServerStreamingServer[Product]) error {
+	return status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductsServer) AddFlowersToProduct(context.Context, *AddFlowersToProductRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFlowersToProduct not implemented")
+}
+func (UnimplementedProductsServer) mustEmbedUnimplementedProductsServer() {}
+func (UnimplementedProductsServer) testEmbeddedByValue()                  {}
+
+// UnsafeProductsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductsServer will
+// result in compilation errors.
+type UnsafeProductsServer interface {
+	mustEmbedUnimplementedProductsServer()
+}
+
+func RegisterProductsServer(s grpc.ServiceRegistrar, srv ProductsServer) {
+	// If the following call pancis, it indicates UnimplementedProductsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Products_ServiceDesc, srv)
+}
+
+func _Products_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetProduct(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_ListProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductsServer).ListProducts(m, &grpc.GenericServerStream[ListProductsRequest, Product]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Products_ListProductsServer = grpc.ServerStreamingServer[Product]
+
+func _Products_AddFlowersToProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFlowersToProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).AddFlowersToProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_AddFlowersToProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).AddFlowersToProduct(ctx, req.(*AddFlowersToProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Products_ServiceDesc is the grpc.ServiceDesc for Products service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Products_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flowermanagement.v1.Products",
+	HandlerType: (*ProductsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _Products_CreateProduct_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _Products_GetProduct_Handler,
+		},
+		{
+			MethodName: "AddFlowersToProduct",
+			Handler:    _Products_AddFlowersToProduct_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListProducts",
+			Handler:       _Products_ListProducts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "flowermanagement.proto",
+}
+
+const (
+	Events_CreateEvent_FullMethodName        = "/flowermanagement.v1.Events/CreateEvent"
+	Events_GetEvent_FullMethodName           = "/flowermanagement.v1.Events/GetEvent"
+	Events_ListEvents_FullMethodName         = "/flowermanagement.v1.Events/ListEvents"
+	Events_AddProductsToEvent_FullMethodName = "/flowermanagement.v1.Events/AddProductsToEvent"
+	Events_GetEventFlowers_FullMethodName    = "/flowermanagement.v1.Events/GetEventFlowers"
+)
+
+// EventsClient is the client API for Events service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Events manages the events and computes the flowers they need.
+type EventsClient interface {
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	GetEvent(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Event, error)
+	// ListEvents streams every event matching the filters, page by page.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	AddProductsToEvent(ctx context.Context, in *AddProductsToEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	// GetEventFlowers packs the flowers the products of an event need.
+	GetEventFlowers(ctx context.Context, in *GetEventFlowersRequest, opts ...grpc.CallOption) (*EventFlowersResponse, error)
+}
+
+type eventsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsClient(cc grpc.ClientConnInterface) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, Events_CreateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) GetEvent(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, Events_GetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Events_ServiceDesc.Streams[0], Events_ListEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Events_ListEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *eventsClient) AddProductsToEvent(ctx context.Context, in *AddProductsToEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Events_AddProductsToEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) GetEventFlowers(ctx context.Context, in *GetEventFlowersRequest, opts ...grpc.CallOption) (*EventFlowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventFlowersResponse)
+	err := c.cc.Invoke(ctx, Events_GetEventFlowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsServer is the server API for Events service.
+// All implementations must embed UnimplementedEventsServer
+// for forward compatibility.
+//
+// Events manages the events and computes the flowers they need.
+type EventsServer interface {
+	CreateEvent(context.Context, *CreateEventRequest) (*CreateResponse, error)
+	GetEvent(context.Context, *GetRequest) (*Event, error)
+	// ListEvents streams every event matching the filters, page by page.
+	ListEvents(*ListEventsRequest, grpc.ServerStreamingServer[Event]) error
+	AddProductsToEvent(context.Context, *AddProductsToEventRequest) (*Empty, error)
+	// GetEventFlowers packs the flowers the products of an event need.
+	GetEventFlowers(context.Context, *GetEventFlowersRequest) (*EventFlowersResponse, error)
+	mustEmbedUnimplementedEventsServer()
+}
+
+// UnimplementedEventsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventsServer struct{}
+
+func (UnimplementedEventsServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventsServer) GetEvent(context.Context, *GetRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventsServer) ListEvents(*ListEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventsServer) AddProductsToEvent(context.Context, *AddProductsToEventRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductsToEvent not implemented")
+}
+func (UnimplementedEventsServer) GetEventFlowers(context.Context, *GetEventFlowersRequest) (*EventFlowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventFlowers not implemented")
+}
+func (UnimplementedEventsServer) mustEmbedUnimplementedEventsServer() {}
+func (UnimplementedEventsServer) testEmbeddedByValue()                {}
+
+// UnsafeEventsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventsServer will
+// result in compilation errors.
+type UnsafeEventsServer interface {
+	mustEmbedUnimplementedEventsServer()
+}
+
+func RegisterEventsServer(s grpc.ServiceRegistrar, srv EventsServer) {
+	// If the following call pancis, it indicates UnimplementedEventsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Events_ServiceDesc, srv)
+}
+
+func _Events_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Events_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Events_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).GetEvent(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_ListEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).ListEvents(m, &grpc.GenericServerStream[ListEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Events_ListEventsServer = grpc.ServerStreamingServer[Event]
+
+func _Events_AddProductsToEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductsToEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).AddProductsToEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Events_AddProductsToEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).AddProductsToEvent(ctx, req.(*AddProductsToEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_GetEventFlowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventFlowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).GetEventFlowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Events_GetEventFlowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).GetEventFlowers(ctx, req.(*GetEventFlowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Events_ServiceDesc is the grpc.ServiceDesc for Events service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Events_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flowermanagement.v1.Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEvent",
+			Handler:    _Events_CreateEvent_Handler,
+		},
+		{
+			MethodName: "GetEvent",
+			Handler:    _Events_GetEvent_Handler,
+		},
+		{
+			MethodName: "AddProductsToEvent",
+			Handler:    _Events_AddProductsToEvent_Handler,
+		},
+		{
+			MethodName: "GetEventFlowers",
+			Handler:    _Events_GetEventFlowers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListEvents",
+			Handler:       _Events_ListEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "flowermanagement.proto",
+}
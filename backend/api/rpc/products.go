@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"context"
+	"flower-management/api/rpc/pb"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type productsServer struct {
+	pb.UnimplementedProductsServer
+}
+
+func (s *productsServer) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateResponse, error) {
+	if err := checkRequired("name", req.GetName()); err != nil {
+		return nil, err
+	}
+
+	createProductRequest := &contracts.CreateProductRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+
+	productID, err := requestService(ctx).CreateProduct(createProductRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CreateResponse{Id: productID}, nil
+}
+
+func (s *productsServer) GetProduct(ctx context.Context, req *pb.GetRequest) (*pb.Product, error) {
+	if err := checkID("product ID", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	product, err := requestService(ctx).GetProduct(req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "product with ID %s does not exist", req.GetId())
+	}
+
+	return productMessage(product), nil
+}
+
+func (s *productsServer) ListProducts(req *pb.ListProductsRequest, stream grpc.ServerStreamingServer[pb.Product]) error {
+	if err := checkIDs("product ID", req.GetIds()); err != nil {
+		return err
+	}
+	if err := checkOneOf("match", req.GetMatch(), matchModes); err != nil {
+		return err
+	}
+	page, err := pageRequest(req.GetPage())
+	if err != nil {
+		return err
+	}
+
+	service := requestService(stream.Context())
+	getFilteredProductsRequest := &contracts.GetFilteredProductsRequest{
+		IDs:            req.GetIds(),
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		Match:          contracts.MatchMode(req.GetMatch()),
+		IncludeDeleted: req.GetIncludeDeleted(),
+		Page:           page,
+	}
+
+	for {
+		products, nextPage, err := service.GetFilteredProducts(getFilteredProductsRequest)
+		if err != nil {
+			return statusError(err)
+		}
+
+		for _, product := range products {
+			if err := stream.Send(productMessage(product)); err != nil {
+				return err
+			}
+		}
+
+		if nextPage.NextCursor == "" {
+			return nil
+		}
+		getFilteredProductsRequest.Page.Cursor = nextPage.NextCursor
+	}
+}
+
+func (s *productsServer) AddFlowersToProduct(ctx context.Context, req *pb.AddFlowersToProductRequest) (*pb.Empty, error) {
+	if err := checkID("product_id", req.GetProductId()); err != nil {
+		return nil, err
+	}
+
+	flowers := make([]contracts.FlowerInProduct, 0, len(req.GetFlowers()))
+	for _, flower := range req.GetFlowers() {
+		if err := checkID("flower_id", flower.GetFlowerId()); err != nil {
+			return nil, err
+		}
+		flowers = append(flowers, contracts.FlowerInProduct{
+			FlowerID:     flower.GetFlowerId(),
+			NumOfFlowers: int(flower.GetNumOfFlowers()),
+		})
+	}
+
+	addFlowersToProductRequest := &contracts.AddFlowersToProductRequest{
+		ProductID: req.GetProductId(),
+		Flowers:   &flowers,
+	}
+
+	if err := requestService(ctx).AddFlowersToProduct(addFlowersToProductRequest); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func productMessage(product *persistency.Product) *pb.Product {
+	return &pb.Product{
+		Id:          product.ID,
+		Name:        product.Name,
+		Description: product.Description,
+		DeletedAt:   timestamp(product.DeletedAt),
+	}
+}
@@ -0,0 +1,86 @@
+// Package rpc serves the flowers, products and events of the ServiceCore over gRPC, next to the REST
+// server. The services are defined in proto/flowermanagement.proto.
+package rpc
+
+//go:generate protoc -I proto --go_out=. --go_opt=module=flower-management/api/rpc --go-grpc_out=. --go-grpc_opt=module=flower-management/api/rpc flowermanagement.proto
+
+import (
+	"context"
+	"flower-management/api/rpc/pb"
+	"flower-management/internal/core/config"
+	"flower-management/internal/core/servicecore"
+	"net"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type GrpcServer struct {
+	port    int
+	server  *grpc.Server
+	service *servicecore.ServiceCore
+	errs    chan error
+}
+
+func NewGrpcServer(cfg *config.GrpcConfig, service *servicecore.ServiceCore) *GrpcServer {
+	authenticator := &authenticator{service: service}
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authenticator.unary),
+		grpc.ChainStreamInterceptor(authenticator.stream),
+	)
+
+	pb.RegisterFlowersServer(server, &flowersServer{})
+	pb.RegisterProductsServer(server, &productsServer{})
+	pb.RegisterEventsServer(server, &eventsServer{})
+	// reflection lets clients such as grpcurl discover the services
+	reflection.Register(server)
+
+	return &GrpcServer{
+		port:    cfg.Port,
+		server:  server,
+		service: service,
+		errs:    make(chan error, 1),
+	}
+}
+
+// Start listens on the port and serves the calls in the background. A port that cannot be listened on
+// is returned, an error stopping the server later on is sent to Errors.
+func (s *GrpcServer) Start() error {
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(s.port))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			s.errs <- err
+		}
+	}()
+
+	return nil
+}
+
+// Errors receives the error the server stopped with, it stays empty once the server is shut down
+func (s *GrpcServer) Errors() <-chan error {
+	return s.errs
+}
+
+// Shutdown stops accepting calls and waits for the calls in flight, the calls still running when ctx is
+// done are cancelled
+func (s *GrpcServer) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		<-stopped
+		return ctx.Err()
+	}
+}
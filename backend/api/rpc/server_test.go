@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"flower-management/api/rpc/pb"
+	"flower-management/internal/core/config"
+	"flower-management/internal/core/servicecore/servicecoretest"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T, authConfig *config.AuthConfig) *grpc.ClientConn {
+	service := servicecoretest.NewWithAuth(mock.NewDalMock(), authConfig)
+	server := NewGrpcServer(&config.GrpcConfig{}, service)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.server.Serve(listener)
+	t.Cleanup(server.server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestListFlowersStreamsEveryPage(t *testing.T) {
+	flowers := pb.NewFlowersClient(newTestClient(t, &config.AuthConfig{}))
+	ctx := context.Background()
+
+	for _, name := range []string{"Rose", "Tulip", "Lily"} {
+		_, err := flowers.CreateFlower(ctx, &pb.CreateFlowerRequest{
+			Name:           name,
+			PackingOptions: []*pb.PackingOption{{Quantity: 10, Price: 5}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stream, err := flowers.ListFlowers(ctx, &pb.ListFlowersRequest{Page: &pb.Page{Size: 1, Sort: "name"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for {
+		flower, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, flower.Name)
+	}
+
+	if len(names) != 3 || names[0] != "Lily" || names[2] != "Tulip" {
+		t.Errorf("every page should be streamed in order, got %v", names)
+	}
+}
+
+func TestStatusCodes(t *testing.T) {
+	conn := newTestClient(t, &config.AuthConfig{})
+	ctx := context.Background()
+
+	_, err := pb.NewFlowersClient(conn).GetFlower(ctx, &pb.GetRequest{Id: "not an id"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("a malformed ID should be an invalid argument, got %v", err)
+	}
+
+	_, err = pb.NewEventsClient(conn).GetEvent(ctx, &pb.GetRequest{Id: "00000000-0000-0000-0000-000000000002"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("a missing event should not be found, got %v", err)
+	}
+}
+
+func TestTokenIsRequired(t *testing.T) {
+	conn := newTestClient(t, &config.AuthConfig{Enabled: true, JWTSecret: "secret"})
+
+	_, err := pb.NewProductsClient(conn).CreateProduct(context.Background(), &pb.CreateProductRequest{Name: "Bouquet"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("calls without a token should be unauthenticated, got %v", err)
+	}
+}
+
+func TestCallsWithoutTenantActInTheDefaultTenant(t *testing.T) {
+	conn := newTestClient(t, &config.AuthConfig{})
+	products := pb.NewProductsClient(conn)
+
+	created, err := products.CreateProduct(context.Background(), &pb.CreateProductRequest{Name: "Bouquet"})
+	if err != nil {
+		t.Fatalf("a call naming no tenant should act in the default tenant, got %v", err)
+	}
+
+	inTenant := func(tenantID string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), tenantKey, tenantID)
+	}
+	if _, err := products.GetProduct(inTenant(persistency.DefaultTenantID), &pb.GetRequest{Id: created.Id}); err != nil {
+		t.Errorf("the product should belong to the default tenant, got %v", err)
+	}
+	_, err = products.GetProduct(inTenant("00000000-0000-0000-0000-000000000002"), &pb.GetRequest{Id: created.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("the product should not be found in another tenant, got %v", err)
+	}
+}
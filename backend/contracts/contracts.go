@@ -6,24 +6,33 @@ import (
 
 type FlowerInProduct struct {
 	FlowerID     string `json:"flower_id"`
-	NumOfFlowers int    `json:"num_of_flowers"`
+	NumOfFlowers int    `json:"num_of_flowers" validate:"gt=0,lte=1000"`
 }
 
 type ProductInEvent struct {
 	ProductID string `json:"product_id"`
-	Quantity  int    `json:"quantity"`
+	Quantity  int    `json:"quantity" validate:"gt=0,lte=10000"`
 }
 
 type PackingOptions struct {
-	Quantity int     `validate:"required,gte=0"`
-	Price    float64 `validate:"required,gte=0"`
+	Quantity   int     `validate:"required,gte=0"`
+	Price      float64 `validate:"required,gte=0"`
+	SupplierID string  `validate:"omitempty,uuid"`
 }
 
 type CreateFlowerRequest struct {
 	Name           string
+	ShelfLifeDays  int
 	PackingOptions *[]PackingOptions
 }
 
+type CreateSupplierRequest struct {
+	Name    string
+	Phone   string
+	Email   string
+	Address string
+}
+
 type CreateProductRequest struct {
 	Name        string
 	Description string
@@ -32,15 +41,42 @@ type CreateProductRequest struct {
 type CreateEventRequest struct {
 	Name        string
 	Date        time.Time
+	CustomerID  string
 	Phone       string
 	Email       string
 	Address     string
 	Description string
 }
 
+type CreateCustomerRequest struct {
+	Name    string
+	Phone   string
+	Email   string
+	Address string
+	Notes   string
+}
+
 type EditFlowerRequest struct {
-	ID   string
-	Name string
+	ID            string
+	Name          string
+	ShelfLifeDays int
+}
+
+type EditSupplierRequest struct {
+	ID      string
+	Name    string
+	Phone   string
+	Email   string
+	Address string
+}
+
+type EditCustomerRequest struct {
+	ID      string
+	Name    string
+	Phone   string
+	Email   string
+	Address string
+	Notes   string
 }
 
 type EditProductRequest struct {
@@ -54,26 +90,85 @@ type EditEventRequest struct {
 	ID          string
 	Name        string
 	Date        time.Time
+	CustomerID  string
 	Phone       string
 	Email       string
 	Address     string
 	Description string
 }
 
+// SortField orders a list by one field, Field is the name of the column
+type SortField struct {
+	Field      string
+	Descending bool
+}
+
+// PageRequest selects one page of a list. Pages are keyset based: Cursor is returned with the
+// previous page and must be sent with the same Sort. A zero Limit returns every row.
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	Sort   []SortField
+}
+
+// MatchMode tells how the text filters of a list compare, they all ignore case
+type MatchMode string
+
+const (
+	// MatchContains finds the filter anywhere in the value, it is the default
+	MatchContains MatchMode = "contains"
+	MatchPrefix   MatchMode = "prefix"
+	MatchExact    MatchMode = "exact"
+)
+
+// Empty filters of the GetFiltered requests match every row, a list filter matches any of its values.
 type GetFilteredFlowersRequest struct {
+	IDs            []string
+	Name           string
+	Match          MatchMode
+	IncludeDeleted bool
+	Page           PageRequest
+}
+
+type GetFilteredSuppliersRequest struct {
 	Name string
 }
 
+// GetFilteredCustomersRequest matches Search against the name, phone and email of a customer
+type GetFilteredCustomersRequest struct {
+	Search string
+}
+
 type GetFilteredProductsRequest struct {
-	Name        string
-	Description string
+	IDs            []string
+	Name           string
+	Description    string
+	Match          MatchMode
+	IncludeDeleted bool
+	Page           PageRequest
 }
 
 type GetFilteredEventsRequest struct {
+	IDs         []string
 	Name        string
-	Date        time.Time
 	Address     string
 	Description string
+	Match       MatchMode
+	// Date matches the events taking place on that day
+	Date time.Time
+	// DateFrom and DateTo bound the date of the events, DateFrom is inclusive and DateTo exclusive
+	DateFrom       time.Time
+	DateTo         time.Time
+	CustomerIDs    []string
+	Statuses       []EventStatus
+	IncludeDeleted bool
+	Page           PageRequest
+}
+
+type SetFlowerPackingOptionsRequest struct {
+	FlowerID       string
+	SupplierID     string
+	PackingOptions *[]PackingOptions
 }
 
 type AddFlowersToProductRequest struct {
@@ -91,10 +186,350 @@ type EditProductsInEventRequest struct {
 	Products *[]ProductInEvent
 }
 
-type FlowersPackagesResponse struct {
+type PackingStrategy string
+
+const (
+	// PackingStrategyCheapest minimizes the total price
+	PackingStrategyCheapest PackingStrategy = "cheapest"
+	// PackingStrategyLeastWaste minimizes the number of overbought flowers, then the price
+	PackingStrategyLeastWaste PackingStrategy = "least-waste"
+	// PackingStrategyFewestPackages minimizes the number of packages, then the price
+	PackingStrategyFewestPackages PackingStrategy = "fewest-packages"
+	// PackingStrategyCheapestWithinWaste minimizes the price while overbuying at most MaxWastePercent of the flowers
+	PackingStrategyCheapestWithinWaste PackingStrategy = "cheapest-within-waste"
+)
+
+type SourcingMode string
+
+const (
+	// SourcingModeCheapestSupplier buys each flower from the single supplier with the best packing
+	SourcingModeCheapestSupplier SourcingMode = "cheapest-supplier"
+	// SourcingModeSplitSuppliers mixes the packing options of all suppliers for each flower
+	SourcingModeSplitSuppliers SourcingMode = "split-suppliers"
+)
+
+type GetFlowersInEventRequest struct {
+	EventID         string
+	Strategy        PackingStrategy
+	MaxWastePercent float64
+	Sourcing        SourcingMode
+	// IgnoreStock packs every flower of the event as if nothing was in stock
+	IgnoreStock bool
+}
+
+type FlowerPackages struct {
+	FlowerID               string
+	FlowerName             string
+	SupplierID             string
+	SupplierName           string
+	NumOfFlowersInPackage  int
+	NumOfPackages          int
+	Price                  float64
+	NumOfFlowersRequired   int
+	NumOfFlowersFromStock  int
+	NumOfFlowersOverbought int
+}
+
+// FlowerFromStock is a flower of the event the stock covers entirely, no package of it is bought
+type FlowerFromStock struct {
 	FlowerID              string
 	FlowerName            string
-	NumOfFlowersInPackage int
-	NumOfPackages         int
-	Price                 float64
+	NumOfFlowersRequired  int
+	NumOfFlowersFromStock int
+}
+
+type FlowersPackagesResponse struct {
+	Strategy               PackingStrategy
+	MaxWastePercent        float64
+	Sourcing               SourcingMode
+	TotalPrice             float64
+	TotalPackages          int
+	TotalFlowersRequired   int
+	TotalFlowersFromStock  int
+	TotalFlowersOverbought int
+	Packages               []*FlowerPackages
+	FromStock              []*FlowerFromStock
+}
+
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderStatusDraft     PurchaseOrderStatus = "draft"
+	PurchaseOrderStatusSent      PurchaseOrderStatus = "sent"
+	PurchaseOrderStatusConfirmed PurchaseOrderStatus = "confirmed"
+	PurchaseOrderStatusReceived  PurchaseOrderStatus = "received"
+)
+
+type CreatePurchaseOrdersRequest struct {
+	EventID         string
+	Strategy        PackingStrategy
+	MaxWastePercent float64
+	Sourcing        SourcingMode
+}
+
+type GetFilteredPurchaseOrdersRequest struct {
+	EventID    string
+	SupplierID string
+	Status     PurchaseOrderStatus
+}
+
+type TransitionPurchaseOrderRequest struct {
+	ID     string
+	Status PurchaseOrderStatus
+}
+
+type StockEntryType string
+
+const (
+	// StockEntryTypeReceipt adds received flowers to the stock
+	StockEntryTypeReceipt StockEntryType = "receipt"
+	// StockEntryTypeAllocation reserves flowers from the stock for an event
+	StockEntryTypeAllocation StockEntryType = "allocation"
+	// StockEntryTypeWaste removes flowers that were thrown away
+	StockEntryTypeWaste StockEntryType = "waste"
+	// StockEntryTypeAdjustment corrects the stock after a manual count, in either direction
+	StockEntryTypeAdjustment StockEntryType = "adjustment"
+)
+
+type AddStockEntryRequest struct {
+	FlowerID   string
+	EntryType  StockEntryType
+	Quantity   int
+	EventID    string
+	LotID      string
+	ReceivedAt time.Time
+	Note       string
+}
+
+type GetStockEntriesRequest struct {
+	FlowerID  string
+	EventID   string
+	LotID     string
+	EntryType StockEntryType
+}
+
+type GetStockLotsRequest struct {
+	FlowerIDs []string
+	// OnlyAvailable keeps the lots that still have flowers in them
+	OnlyAvailable bool
+	// ExpiringBefore keeps the lots that expire before the given time
+	ExpiringBefore time.Time
+	// FreshAt keeps the lots that have not expired yet at the given time
+	FreshAt time.Time
+}
+
+type GetEventQuoteRequest struct {
+	EventID         string
+	Strategy        PackingStrategy
+	MaxWastePercent float64
+	Sourcing        SourcingMode
+}
+
+type QuoteLineItem struct {
+	ProductID         string
+	ProductName       string
+	Quantity          int
+	FlowerCostPerUnit float64
+	LaborCostPerUnit  float64
+	UnitPrice         float64
+	LineTotal         float64
+}
+
+type EventQuoteResponse struct {
+	EventID       string
+	EventName     string
+	EventDate     time.Time
+	Strategy      PackingStrategy
+	MarkupPercent float64
+	TaxPercent    float64
+	LineItems     []*QuoteLineItem
+	Subtotal      float64
+	Tax           float64
+	GrandTotal    float64
+}
+
+type EventStatus string
+
+const (
+	EventStatusInquiry      EventStatus = "inquiry"
+	EventStatusQuoted       EventStatus = "quoted"
+	EventStatusConfirmed    EventStatus = "confirmed"
+	EventStatusInProduction EventStatus = "in-production"
+	EventStatusDelivered    EventStatus = "delivered"
+	EventStatusClosed       EventStatus = "closed"
+	EventStatusCancelled    EventStatus = "cancelled"
+)
+
+type TransitionEventRequest struct {
+	ID     string
+	Status EventStatus
+}
+
+type QuoteStatus string
+
+const (
+	QuoteStatusDraft    QuoteStatus = "draft"
+	QuoteStatusSent     QuoteStatus = "sent"
+	QuoteStatusAccepted QuoteStatus = "accepted"
+	QuoteStatusRejected QuoteStatus = "rejected"
+	QuoteStatusExpired  QuoteStatus = "expired"
+)
+
+type CreateQuoteRequest struct {
+	EventID         string
+	Strategy        PackingStrategy
+	MaxWastePercent float64
+	Sourcing        SourcingMode
+}
+
+type TransitionQuoteRequest struct {
+	ID     string
+	Status QuoteStatus
+}
+
+type DiffQuotesRequest struct {
+	EventID     string
+	FromVersion int
+	ToVersion   int
+}
+
+type QuoteChange string
+
+const (
+	QuoteChangeAdded   QuoteChange = "added"
+	QuoteChangeRemoved QuoteChange = "removed"
+	QuoteChangeChanged QuoteChange = "changed"
+)
+
+type QuoteLineItemDiff struct {
+	ProductID     string
+	ProductName   string
+	Change        QuoteChange
+	FromQuantity  int
+	ToQuantity    int
+	FromUnitPrice float64
+	ToUnitPrice   float64
+	FromLineTotal float64
+	ToLineTotal   float64
+}
+
+type QuoteDiffResponse struct {
+	EventID         string
+	FromVersion     int
+	ToVersion       int
+	LineItems       []*QuoteLineItemDiff
+	SubtotalDelta   float64
+	TaxDelta        float64
+	GrandTotalDelta float64
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate  AuditAction = "create"
+	AuditActionEdit    AuditAction = "edit"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionRestore AuditAction = "restore"
+	AuditActionPurge   AuditAction = "purge"
+)
+
+type AuditEntityType string
+
+const (
+	AuditEntityFlower        AuditEntityType = "flower"
+	AuditEntityProduct       AuditEntityType = "product"
+	AuditEntityEvent         AuditEntityType = "event"
+	AuditEntitySupplier      AuditEntityType = "supplier"
+	AuditEntityCustomer      AuditEntityType = "customer"
+	AuditEntityPurchaseOrder AuditEntityType = "purchase-order"
+	AuditEntityStockLot      AuditEntityType = "stock-lot"
+	AuditEntityQuote         AuditEntityType = "quote"
+	AuditEntityUser          AuditEntityType = "user"
+	AuditEntityAPIToken      AuditEntityType = "api-token"
+)
+
+type GetAuditRecordsRequest struct {
+	EntityType AuditEntityType
+	EntityID   string
+}
+
+// SearchEntityType is the kind of entity a search result points at
+type SearchEntityType string
+
+const (
+	SearchEntityFlower  SearchEntityType = "flower"
+	SearchEntityProduct SearchEntityType = "product"
+	SearchEntityEvent   SearchEntityType = "event"
+)
+
+// SearchRequest runs a full text search, Limit caps the results of every entity type
+type SearchRequest struct {
+	Query string
+	Limit int
+}
+
+// SearchResult is a match of a search, the matched words of Snippet are wrapped in <mark> tags
+type SearchResult struct {
+	ID      string
+	Title   string
+	Snippet string
+	Rank    float64
+}
+
+// SearchResponse groups the results by entity type, each group is ordered by rank
+type SearchResponse struct {
+	Query    string
+	Flowers  []*SearchResult
+	Products []*SearchResult
+	Events   []*SearchResult
+}
+
+// Role decides what a user may do, see servicecore.rolePermissions
+type Role string
+
+const (
+	RoleOwner    Role = "owner"
+	RolePlanner  Role = "planner"
+	RoleFlorist  Role = "florist"
+	RoleBuyer    Role = "buyer"
+	RoleReadOnly Role = "read-only"
+)
+
+type CreateUserRequest struct {
+	Username string
+	Password string
+	Role     Role
+}
+
+type AssignRoleRequest struct {
+	UserID string
+	Role   Role
+}
+
+type LoginRequest struct {
+	Username string
+	Password string
+}
+
+// LoginResponse holds a signed token to send as "Authorization: Bearer <token>"
+type LoginResponse struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type CreateAPITokenRequest struct {
+	UserID string
+	Name   string
+}
+
+// CreateAPITokenResponse is the only time the token is shown, only its hash is stored
+type CreateAPITokenResponse struct {
+	ID    string
+	Name  string
+	Token string
+}
+
+type RevokeAPITokenRequest struct {
+	ID     string
+	UserID string
 }
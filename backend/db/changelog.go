@@ -0,0 +1,28 @@
+// Package db holds the Liquibase changelog of the database. The changelog is embedded so the server
+// knows which changesets its code expects to be applied.
+package db
+
+import (
+	_ "embed"
+	"encoding/xml"
+)
+
+//go:embed changelog.xml
+var changelog []byte
+
+type ChangeSet struct {
+	ID     string `xml:"id,attr"`
+	Author string `xml:"author,attr"`
+}
+
+// ChangeSets returns the changesets of the changelog in the order Liquibase applies them
+func ChangeSets() ([]ChangeSet, error) {
+	var document struct {
+		ChangeSets []ChangeSet `xml:"changeSet"`
+	}
+	if err := xml.Unmarshal(changelog, &document); err != nil {
+		return nil, err
+	}
+
+	return document.ChangeSets, nil
+}
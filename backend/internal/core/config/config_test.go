@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJWTSecretIsChecked(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "test.env")
+	if err := os.WriteFile(envFile, []byte("# local settings\nPORAHAT_DB_MOCKED=true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		enabled string
+		wantErr bool
+	}{
+		{name: "missing", secret: "", wantErr: true},
+		{name: "well known", secret: "local-development-secret", wantErr: true},
+		{name: "short", secret: "Zq8pN2vX4rT7", wantErr: true},
+		{name: "random", secret: "q3Jx9Lw2Vb7Kp5Rt8Yn4Hs6Dm1Fc0Ga2E"},
+		{name: "not used without auth", secret: "", enabled: "false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(authJWTSecret, tt.secret)
+			if tt.enabled != "" {
+				t.Setenv(authEnabled, tt.enabled)
+			}
+
+			configSet, err := LoadConfig(envFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want an error: %v", err, tt.wantErr)
+			}
+			if err == nil && configSet.AuthConfig.JWTSecret != tt.secret {
+				t.Errorf("the secret should be read from the environment, got %q", configSet.AuthConfig.JWTSecret)
+			}
+		})
+	}
+}
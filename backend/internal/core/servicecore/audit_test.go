@@ -0,0 +1,117 @@
+package servicecore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+// recipe reads the number of flowers per flower from the snapshot of a product
+func recipe(t *testing.T, snapshot json.RawMessage) map[string]int {
+	var product struct {
+		Flowers []persistency.FlowerInProduct `json:"flowers"`
+	}
+	if err := json.Unmarshal(snapshot, &product); err != nil {
+		t.Fatal(err)
+	}
+
+	flowers := make(map[string]int)
+	for _, flower := range product.Flowers {
+		flowers[flower.FlowerID] = flower.NumOfFlowers
+	}
+	return flowers
+}
+
+func TestAuditRecordsRecipeChanges(t *testing.T) {
+	dal := mock.NewDalMock()
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{{Quantity: 10, Price: 5}})
+	service := newTestServiceCore(dal).WithActor("dana")
+
+	productID, err := service.CreateProduct(&contracts.CreateProductRequest{Name: "Bouquet"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	flowers := func(numOfFlowers int) *contracts.AddFlowersToProductRequest {
+		return &contracts.AddFlowersToProductRequest{ProductID: productID, Flowers: &[]contracts.FlowerInProduct{
+			{FlowerID: "rose", NumOfFlowers: numOfFlowers},
+		}}
+	}
+	if err := service.AddFlowersToProduct(flowers(12)); err != nil {
+		t.Fatal(err)
+	}
+	if err := newTestServiceCore(dal).WithActor("yossi").EditFlowersInProduct(flowers(10)); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.DeleteProduct(productID); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := service.GetAuditRecords(&contracts.GetAuditRecordsRequest{EntityType: contracts.AuditEntityProduct, EntityID: productID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("expected the create, the two recipe changes and the delete, got %d records", len(records))
+	}
+
+	for i, want := range []struct {
+		action contracts.AuditAction
+		actor  string
+	}{
+		{contracts.AuditActionCreate, "dana"},
+		{contracts.AuditActionEdit, "dana"},
+		{contracts.AuditActionEdit, "yossi"},
+		{contracts.AuditActionDelete, "dana"},
+	} {
+		if records[i].Action != want.action || records[i].Actor != want.actor {
+			t.Errorf("record %d: got %s by %s, want %s by %s", i, records[i].Action, records[i].Actor, want.action, want.actor)
+		}
+	}
+
+	if records[0].Before != nil || records[0].After == nil {
+		t.Errorf("a create should only have an after, got %s and %s", records[0].Before, records[0].After)
+	}
+	recipeChange := records[2]
+	if before, after := recipe(t, recipeChange.Before), recipe(t, recipeChange.After); before["rose"] != 12 || after["rose"] != 10 {
+		t.Errorf("the recipe change should go from 12 to 10 roses, got %v and %v", before, after)
+	}
+
+	var deleted struct {
+		Product persistency.Product `json:"product"`
+	}
+	if err := json.Unmarshal(records[3].After, &deleted); err != nil {
+		t.Fatal(err)
+	}
+	if deleted.Product.DeletedAt == nil {
+		t.Errorf("the delete should record the product as deleted, got %s", records[3].After)
+	}
+}
+
+func TestAuditRecordsAreKeptPerTenant(t *testing.T) {
+	dal := mock.NewDalMock()
+	north := newTestServiceCore(dal).WithTenant("north")
+	south := newTestServiceCore(dal).WithTenant("south")
+
+	if _, err := north.CreateProduct(&contracts.CreateProductRequest{Name: "Bouquet"}); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := north.GetAuditRecords(&contracts.GetAuditRecordsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Actor != "anonymous" {
+		t.Errorf("expected the create by an anonymous actor, got %+v", records)
+	}
+
+	records, err = south.GetAuditRecords(&contracts.GetAuditRecordsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Errorf("a tenant should not see the changes of another, got %+v", records)
+	}
+}
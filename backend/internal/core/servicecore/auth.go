@@ -0,0 +1,207 @@
+package servicecore
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrUnauthenticated    = errors.New("missing or invalid token")
+)
+
+const (
+	// apiTokenPrefix tells API tokens apart from login tokens
+	apiTokenPrefix    = "phat_"
+	minPasswordLength = 8
+)
+
+// tokenClaims are the claims of a login token, the subject is the ID of the user
+type tokenClaims struct {
+	Name string `json:"name,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// dummyPasswordHash is compared against when a login names an unknown user, so the response time
+// does not reveal which usernames exist
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+	return hash
+})
+
+func (s *ServiceCore) CreateUser(createUserRequest *contracts.CreateUserRequest) (string, error) {
+	if err := s.authorize(PermissionManageUsers); err != nil {
+		return "", err
+	}
+
+	if !ValidRole(createUserRequest.Role) {
+		return "", persistency.Invalid("unknown role %s", createUserRequest.Role)
+	}
+	if len(createUserRequest.Password) < minPasswordLength {
+		return "", persistency.Invalid("password must have at least %d characters", minPasswordLength)
+	}
+
+	existing, err := s.DalInstance.GetUserByUsername(createUserRequest.Username)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", persistency.Conflict("user %s already exists", createUserRequest.Username)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(createUserRequest.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := &persistency.User{
+		Username:     createUserRequest.Username,
+		PasswordHash: string(passwordHash),
+		Role:         createUserRequest.Role,
+	}
+	err = s.DalInstance.CreateUser(user)
+
+	return user.ID, err
+}
+
+func (s *ServiceCore) GetUser(id string) (*persistency.User, error) {
+	return s.DalInstance.GetUser(id)
+}
+
+// Login checks the password of a user and issues a signed token for it.
+func (s *ServiceCore) Login(loginRequest *contracts.LoginRequest) (*contracts.LoginResponse, error) {
+	user, err := s.DalInstance.GetUserByUsername(loginRequest.Username)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(loginRequest.Password))
+		return nil, ErrInvalidCredentials
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(loginRequest.Password))
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Duration(s.AuthConfig.TokenTTLMinutes) * time.Minute)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &tokenClaims{
+		Name: user.Username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID,
+			Issuer:    s.AuthConfig.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}).SignedString([]byte(s.AuthConfig.JWTSecret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return &contracts.LoginResponse{
+		Token:     token,
+		ExpiresAt: time.Unix(expiresAt.Unix(), 0).UTC(),
+	}, nil
+}
+
+// Authenticate returns the user a login token or an API token belongs to.
+func (s *ServiceCore) Authenticate(token string) (*persistency.User, error) {
+	userID, err := s.tokenUserID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.DalInstance.GetUser(userID)
+	if err != nil || user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	return user, nil
+}
+
+func (s *ServiceCore) tokenUserID(token string) (string, error) {
+	if strings.HasPrefix(token, apiTokenPrefix) {
+		apiToken, err := s.DalInstance.GetAPITokenByHash(hashAPIToken(token))
+		if err != nil {
+			return "", err
+		}
+		if apiToken == nil || apiToken.RevokedAt != nil {
+			return "", ErrUnauthenticated
+		}
+		return apiToken.UserID, nil
+	}
+
+	// only HS256 is issued, tokens signed otherwise or without an expiry are rejected
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return []byte(s.AuthConfig.JWTSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(s.AuthConfig.Issuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", ErrUnauthenticated
+	}
+	return claims.Subject, nil
+}
+
+// CreateAPIToken issues a long lived token for scripts. The token is only returned here, only its
+// hash is stored.
+func (s *ServiceCore) CreateAPIToken(createAPITokenRequest *contracts.CreateAPITokenRequest) (*contracts.CreateAPITokenResponse, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate api token: %w", err)
+	}
+	token := apiTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiToken := &persistency.APIToken{
+		UserID:    createAPITokenRequest.UserID,
+		Name:      createAPITokenRequest.Name,
+		TokenHash: hashAPIToken(token),
+	}
+	if err := s.DalInstance.CreateAPIToken(apiToken); err != nil {
+		return nil, err
+	}
+
+	return &contracts.CreateAPITokenResponse{
+		ID:    apiToken.ID,
+		Name:  apiToken.Name,
+		Token: token,
+	}, nil
+}
+
+func (s *ServiceCore) GetUserAPITokens(userID string) ([]*persistency.APIToken, error) {
+	return s.DalInstance.GetUserAPITokens(userID)
+}
+
+// RevokeAPIToken revokes a token of the user, tokens of other users cannot be revoked.
+func (s *ServiceCore) RevokeAPIToken(revokeAPITokenRequest *contracts.RevokeAPITokenRequest) error {
+	tokens, err := s.DalInstance.GetUserAPITokens(revokeAPITokenRequest.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		if token.ID == revokeAPITokenRequest.ID {
+			return s.DalInstance.RevokeAPIToken(token.ID)
+		}
+	}
+
+	return persistency.NotFound("api token with ID %s does not exist", revokeAPITokenRequest.ID)
+}
+
+// hashAPIToken returns the hex SHA-256 of a token. API tokens are random so a fast hash is enough.
+func hashAPIToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,110 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	"flower-management/internal/core/config"
+	"flower-management/internal/persistency/mock"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestLoginAndTokens(t *testing.T) {
+	dal := mock.NewDalMock()
+	authConfig := &config.AuthConfig{Enabled: true, JWTSecret: "secret", Issuer: "porahat", TokenTTLMinutes: 60}
+	service := newTestServiceCore(dal)
+	service.AuthConfig = authConfig
+
+	userID, err := service.AsSystem("create-user").CreateUser(&contracts.CreateUserRequest{Username: "dana", Password: "correct horse", Role: contracts.RoleOwner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.AsSystem("create-user").CreateUser(&contracts.CreateUserRequest{Username: "dana", Password: "another one", Role: contracts.RoleOwner}); err == nil {
+		t.Errorf("usernames should be unique")
+	}
+
+	if _, err := service.Login(&contracts.LoginRequest{Username: "dana", Password: "wrong password"}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("a wrong password should be rejected, got %v", err)
+	}
+	if _, err := service.Login(&contracts.LoginRequest{Username: "nobody", Password: "correct horse"}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("an unknown user should be rejected, got %v", err)
+	}
+
+	login, err := service.Login(&contracts.LoginRequest{Username: "dana", Password: "correct horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := service.Authenticate(login.Token)
+	if err != nil || user.ID != userID {
+		t.Fatalf("login token should authenticate the user, got %v, %v", user, err)
+	}
+
+	apiToken, err := service.CreateAPIToken(&contracts.CreateAPITokenRequest{UserID: userID, Name: "script"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user, err := service.Authenticate(apiToken.Token); err != nil || user.ID != userID {
+		t.Fatalf("api token should authenticate the user, got %v, %v", user, err)
+	}
+
+	if err := service.RevokeAPIToken(&contracts.RevokeAPITokenRequest{ID: apiToken.ID, UserID: "someone else"}); err == nil {
+		t.Errorf("tokens of another user should not be revoked")
+	}
+	if err := service.RevokeAPIToken(&contracts.RevokeAPITokenRequest{ID: apiToken.ID, UserID: userID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Authenticate(apiToken.Token); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("a revoked api token should be rejected, got %v", err)
+	}
+	if _, err := service.Authenticate("not a token"); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("garbage should be rejected, got %v", err)
+	}
+}
+
+func TestLoginTokensAreVerified(t *testing.T) {
+	authConfig := &config.AuthConfig{Enabled: true, JWTSecret: "q3Jx9Lw2Vb7Kp5Rt8Yn4Hs6Dm1Fc0Ga2E", Issuer: "porahat", TokenTTLMinutes: 60}
+	service := newTestServiceCore(mock.NewDalMock())
+	service.AuthConfig = authConfig
+	userID, err := service.AsSystem("create-user").CreateUser(&contracts.CreateUserRequest{Username: "dana", Password: "correct horse", Role: contracts.RoleOwner})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(method jwt.SigningMethod, key interface{}, claims jwt.RegisteredClaims) string {
+		token, err := jwt.NewWithClaims(method, &tokenClaims{RegisteredClaims: claims}).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	secret := []byte(authConfig.JWTSecret)
+	now := time.Now()
+	valid := jwt.RegisteredClaims{Subject: userID, Issuer: "porahat", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}
+
+	if user, err := service.Authenticate(sign(jwt.SigningMethodHS256, secret, valid)); err != nil || user.ID != userID {
+		t.Fatalf("a valid token should authenticate the user, got %v, %v", user, err)
+	}
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+	otherIssuer := valid
+	otherIssuer.Issuer = "someone"
+	withoutExpiry := valid
+	withoutExpiry.ExpiresAt = nil
+
+	for name, token := range map[string]string{
+		"another secret": sign(jwt.SigningMethodHS256, []byte("another secret of at least 32 chars"), valid),
+		"another method": sign(jwt.SigningMethodHS512, secret, valid),
+		"no signature":   sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid),
+		"an expiry past": sign(jwt.SigningMethodHS256, secret, expired),
+		"another issuer": sign(jwt.SigningMethodHS256, secret, otherIssuer),
+		"no expiry":      sign(jwt.SigningMethodHS256, secret, withoutExpiry),
+	} {
+		if _, err := service.Authenticate(token); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("a token with %s should be rejected, got %v", name, err)
+		}
+	}
+}
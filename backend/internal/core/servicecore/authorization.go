@@ -0,0 +1,144 @@
+package servicecore
+
+import (
+	"errors"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+)
+
+var ErrForbidden = errors.New("permission denied")
+
+// Permission is an action the authorizer checks before a ServiceCore method runs
+type Permission string
+
+const (
+	// PermissionView allows reading everything but the audit log
+	PermissionView Permission = "view"
+	// PermissionManageCatalog allows creating and editing flowers and products
+	PermissionManageCatalog Permission = "manage-catalog"
+	// PermissionManagePrices allows setting the packing options and prices of flowers
+	PermissionManagePrices Permission = "manage-prices"
+	// PermissionManageEvents allows creating and editing events, their products and customers
+	PermissionManageEvents Permission = "manage-events"
+	// PermissionManageQuotes allows creating quote versions and moving them through their statuses
+	PermissionManageQuotes Permission = "manage-quotes"
+	// PermissionManagePurchasing allows managing suppliers and purchase orders
+	PermissionManagePurchasing Permission = "manage-purchasing"
+	// PermissionManageStock allows recording stock movements
+	PermissionManageStock Permission = "manage-stock"
+	// PermissionDelete allows deleting, restoring and purging
+	PermissionDelete Permission = "delete"
+	// PermissionManageUsers allows creating users and assigning their roles
+	PermissionManageUsers Permission = "manage-users"
+	// PermissionViewAudit allows reading the audit log
+	PermissionViewAudit Permission = "view-audit"
+	// PermissionManageTenants is granted to no role, tenants are created from the command line
+	PermissionManageTenants Permission = "manage-tenants"
+)
+
+var rolePermissions = map[contracts.Role][]Permission{
+	contracts.RoleOwner: {
+		PermissionView, PermissionManageCatalog, PermissionManagePrices, PermissionManageEvents, PermissionManageQuotes,
+		PermissionManagePurchasing, PermissionManageStock, PermissionDelete, PermissionManageUsers, PermissionViewAudit,
+	},
+	contracts.RolePlanner: {
+		PermissionView, PermissionManageCatalog, PermissionManageEvents, PermissionManageQuotes,
+	},
+	contracts.RoleFlorist: {
+		PermissionView, PermissionManageCatalog, PermissionManageStock,
+	},
+	contracts.RoleBuyer: {
+		PermissionView, PermissionManagePrices, PermissionManagePurchasing, PermissionManageStock,
+	},
+	contracts.RoleReadOnly: {
+		PermissionView,
+	},
+}
+
+// ValidRole reports whether role is one of the known roles
+func ValidRole(role contracts.Role) bool {
+	_, found := rolePermissions[role]
+	return found
+}
+
+// HasPermission reports whether users with the role may perform the permission
+func HasPermission(role contracts.Role, permission Permission) bool {
+	for _, rolePermission := range rolePermissions[role] {
+		if rolePermission == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithUser returns a ServiceCore acting for the user in the tenant of the user, its calls are
+// authorized against the role of the user and its changes are recorded in the audit log as made by
+// the user
+func (s *ServiceCore) WithUser(user *persistency.User) *ServiceCore {
+	serviceCore := s.WithActor(user.Username).WithTenant(user.TenantID)
+	serviceCore.user = user
+	serviceCore.system = false
+	return serviceCore
+}
+
+// AsSystem returns a ServiceCore acting for the system itself, as the commands run from the command
+// line do. It may do anything and its changes are recorded in the audit log as made by actor.
+func (s *ServiceCore) AsSystem(actor string) *ServiceCore {
+	serviceCore := s.WithActor(actor)
+	serviceCore.user = nil
+	serviceCore.system = true
+	return serviceCore
+}
+
+// authorize checks that the user the service acts for has the permission. The system may do anything,
+// a service acting for nobody is only let through while authentication is disabled.
+func (s *ServiceCore) authorize(permission Permission) error {
+	switch {
+	case s.system:
+		return nil
+	case s.user != nil:
+		if HasPermission(s.user.Role, permission) {
+			return nil
+		}
+		return fmt.Errorf("%w: role %s cannot %s", ErrForbidden, s.user.Role, permission)
+	case s.AuthConfig.Enabled:
+		return ErrUnauthenticated
+	}
+
+	return nil
+}
+
+func (s *ServiceCore) GetUsers() ([]*persistency.User, error) {
+	if err := s.authorize(PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetUsers()
+}
+
+// AssignRole changes the role of a user. Owners cannot demote themselves so the shop always keeps an
+// owner able to assign roles.
+func (s *ServiceCore) AssignRole(req *contracts.AssignRoleRequest) error {
+	if err := s.authorize(PermissionManageUsers); err != nil {
+		return err
+	}
+
+	if !ValidRole(req.Role) {
+		return persistency.Invalid("unknown role %s", req.Role)
+	}
+	if s.user != nil && s.user.ID == req.UserID && req.Role != contracts.RoleOwner {
+		return persistency.Invalid("owners cannot change their own role")
+	}
+
+	user, err := s.DalInstance.GetUser(req.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return persistency.NotFound("user with ID %s does not exist", req.UserID)
+	}
+
+	return s.DalInstance.SetUserRole(req.UserID, req.Role)
+}
@@ -0,0 +1,121 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+
+	"flower-management/contracts"
+	"flower-management/internal/core/config"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestRolePermissions(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{})
+	dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Status: contracts.EventStatusInquiry})
+
+	florist := service.WithUser(&persistency.User{TenantID: persistency.DefaultTenantID, Username: "florist", Role: contracts.RoleFlorist})
+	if _, err := florist.GetEvent("wedding"); err != nil {
+		t.Errorf("florists should view events: %v", err)
+	}
+	if _, err := florist.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{EventID: "wedding"}); err != nil {
+		t.Errorf("florists should view flower needs: %v", err)
+	}
+	err := florist.SetFlowerPackingOptions(&contracts.SetFlowerPackingOptionsRequest{
+		FlowerID:       "rose",
+		PackingOptions: &[]contracts.PackingOptions{{Quantity: 10, Price: 5}},
+	})
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("florists should not change prices, got %v", err)
+	}
+	_, err = florist.CreateFlower(&contracts.CreateFlowerRequest{
+		Name:           "Tulip",
+		PackingOptions: &[]contracts.PackingOptions{{Quantity: 10, Price: 5}},
+	})
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("florists should not price new flowers, got %v", err)
+	}
+
+	for _, role := range []contracts.Role{contracts.RolePlanner, contracts.RoleFlorist, contracts.RoleBuyer, contracts.RoleReadOnly} {
+		user := service.WithUser(&persistency.User{TenantID: persistency.DefaultTenantID, Username: string(role), Role: role})
+		if err := user.DeleteFlower("rose"); !errors.Is(err, ErrForbidden) {
+			t.Errorf("%s should not delete, got %v", role, err)
+		}
+	}
+
+	readOnly := service.WithUser(&persistency.User{TenantID: persistency.DefaultTenantID, Username: "viewer", Role: contracts.RoleReadOnly})
+	if _, err := readOnly.CreateEvent(&contracts.CreateEventRequest{Name: "Party"}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("read-only users should not create events, got %v", err)
+	}
+
+	owner := service.WithUser(&persistency.User{TenantID: persistency.DefaultTenantID, Username: "owner", Role: contracts.RoleOwner})
+	if err := owner.DeleteFlower("rose"); err != nil {
+		t.Errorf("owners should delete: %v", err)
+	}
+}
+
+func TestAssignRole(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	ownerID, err := service.CreateUser(&contracts.CreateUserRequest{Username: "owner", Password: "owner password", Role: contracts.RoleOwner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	staffID, err := service.CreateUser(&contracts.CreateUserRequest{Username: "staff", Password: "staff password", Role: contracts.RoleReadOnly})
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner, _ := dal.GetUser(ownerID)
+	staff, _ := dal.GetUser(staffID)
+
+	asStaff := service.WithUser(staff)
+	if err := asStaff.AssignRole(&contracts.AssignRoleRequest{UserID: staffID, Role: contracts.RoleOwner}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("only owners should assign roles, got %v", err)
+	}
+
+	asOwner := service.WithUser(owner)
+	if err := asOwner.AssignRole(&contracts.AssignRoleRequest{UserID: staffID, Role: contracts.RolePlanner}); err != nil {
+		t.Fatal(err)
+	}
+	if staff.Role != contracts.RolePlanner {
+		t.Errorf("role should be planner, got %s", staff.Role)
+	}
+	if err := asOwner.AssignRole(&contracts.AssignRoleRequest{UserID: ownerID, Role: contracts.RoleReadOnly}); err == nil {
+		t.Errorf("owners should not demote themselves")
+	}
+	if err := asOwner.AssignRole(&contracts.AssignRoleRequest{UserID: staffID, Role: "admin"}); err == nil {
+		t.Errorf("unknown roles should be rejected")
+	}
+}
+
+func TestAuthorizeFailsClosed(t *testing.T) {
+	dal := mock.NewDalMock()
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{})
+
+	enabled := newTestServiceCore(dal)
+	enabled.AuthConfig = &config.AuthConfig{Enabled: true}
+	if err := enabled.DeleteFlower("rose"); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("a service acting for nobody should be refused while auth is enabled, got %v", err)
+	}
+	if _, err := enabled.GetTenants(); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("tenants should not be listed for nobody, got %v", err)
+	}
+
+	system := enabled.AsSystem("purge")
+	if _, err := system.GetTenants(); err != nil {
+		t.Errorf("the system should list the tenants: %v", err)
+	}
+	if _, err := system.WithTenant("north").PurgeDeleted(30); err != nil {
+		t.Errorf("the system should purge a tenant: %v", err)
+	}
+	readOnly := system.WithUser(&persistency.User{TenantID: persistency.DefaultTenantID, Username: "viewer", Role: contracts.RoleReadOnly})
+	if err := readOnly.DeleteFlower("rose"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("a user should not inherit the permissions of the system, got %v", err)
+	}
+
+	if err := newTestServiceCore(dal).DeleteFlower("rose"); err != nil {
+		t.Errorf("a service acting for nobody should be let through while auth is disabled: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+func (s *ServiceCore) CreateCustomer(createCustomerRequest *contracts.CreateCustomerRequest) (string, error) {
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return "", err
+	}
+
+	customer := &persistency.Customer{
+		Name:    createCustomerRequest.Name,
+		Phone:   createCustomerRequest.Phone,
+		Email:   createCustomerRequest.Email,
+		Address: createCustomerRequest.Address,
+		Notes:   createCustomerRequest.Notes,
+	}
+	err := s.DalInstance.CreateCustomer(customer)
+
+	return customer.ID, err
+}
+
+func (s *ServiceCore) EditCustomer(editCustomerRequest *contracts.EditCustomerRequest) error {
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return err
+	}
+
+	customer := &persistency.Customer{
+		ID:      editCustomerRequest.ID,
+		Name:    editCustomerRequest.Name,
+		Phone:   editCustomerRequest.Phone,
+		Email:   editCustomerRequest.Email,
+		Address: editCustomerRequest.Address,
+		Notes:   editCustomerRequest.Notes,
+	}
+
+	return s.DalInstance.EditCustomer(customer)
+}
+
+func (s *ServiceCore) DeleteCustomer(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.DalInstance.DeleteCustomer(id)
+}
+
+func (s *ServiceCore) GetFilteredCustomers(req *contracts.GetFilteredCustomersRequest) ([]*persistency.Customer, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetFilteredCustomers(req)
+}
+
+func (s *ServiceCore) GetCustomer(id string) (*persistency.Customer, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.getCustomer(id)
+}
+
+// GetCustomerEvents returns the event history of a customer.
+func (s *ServiceCore) GetCustomerEvents(customerID string) ([]*persistency.Event, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getCustomer(customerID); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetCustomerEvents(customerID)
+}
+
+// fillEventContactFromCustomer checks the customer of an event exists and uses its phone and email
+// when the event has none. The address is left alone, it is where the event takes place.
+func (s *ServiceCore) fillEventContactFromCustomer(event *persistency.Event) error {
+	if event.CustomerID == "" {
+		return nil
+	}
+
+	customer, err := s.getCustomer(event.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	if event.Phone == "" {
+		event.Phone = customer.Phone
+	}
+	if event.Email == "" {
+		event.Email = customer.Email
+	}
+
+	return nil
+}
+
+func (s *ServiceCore) getCustomer(id string) (*persistency.Customer, error) {
+	customer, err := s.DalInstance.GetCustomer(id)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, persistency.NotFound("customer with ID %s does not exist", id)
+	}
+
+	return customer, nil
+}
@@ -0,0 +1,168 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestCustomerLifecycle(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+
+	id, err := service.CreateCustomer(&contracts.CreateCustomerRequest{Name: "Dana Levi", Phone: "050-1234567", Email: "dana@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id == "" {
+		t.Fatal("a new customer should get an ID")
+	}
+
+	err = service.EditCustomer(&contracts.EditCustomerRequest{ID: id, Name: "Dana Cohen", Phone: "050-1234567", Email: "dana@example.com", Notes: "prefers white"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	customer, err := service.GetCustomer(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.Name != "Dana Cohen" || customer.Notes != "prefers white" {
+		t.Errorf("the customer should be edited, got %+v", customer)
+	}
+
+	eventID, err := service.CreateEvent(&contracts.CreateEventRequest{Name: "Wedding", CustomerID: id, Address: "Hall"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.DeleteCustomer(id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.GetCustomer(id); !errors.Is(err, persistency.ErrNotFound) {
+		t.Errorf("a deleted customer should not be found, got %v", err)
+	}
+	event, err := dal.GetEvent(eventID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.CustomerID != "" || event.Phone != "050-1234567" {
+		t.Errorf("the event should keep its contact details without the customer, got %+v", event)
+	}
+}
+
+func TestGetFilteredCustomers(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	for _, customer := range []*persistency.Customer{
+		{Name: "Dana Levi", Phone: "050-1234567", Email: "dana@example.com"},
+		{Name: "Yossi Mizrahi", Phone: "052-7654321", Email: "yossi@flowers.co.il"},
+		{Name: "Noa Danino", Phone: "054-1112222", Email: "noa@example.com"},
+	} {
+		dal.CreateCustomer(customer)
+	}
+
+	tests := []struct {
+		search string
+		want   []string
+	}{
+		{search: "", want: []string{"Dana Levi", "Yossi Mizrahi", "Noa Danino"}},
+		{search: "dan", want: []string{"Dana Levi", "Noa Danino"}},
+		{search: "765", want: []string{"Yossi Mizrahi"}},
+		{search: "EXAMPLE.COM", want: []string{"Dana Levi", "Noa Danino"}},
+		{search: "tulip", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		customers, err := service.GetFilteredCustomers(&contracts.GetFilteredCustomersRequest{Search: tt.search})
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := []string{}
+		for _, customer := range customers {
+			names = append(names, customer.Name)
+		}
+		if len(names) != len(tt.want) {
+			t.Errorf("search %q found %v, want %v", tt.search, names, tt.want)
+			continue
+		}
+		for i := range names {
+			if names[i] != tt.want[i] {
+				t.Errorf("search %q found %v, want %v", tt.search, names, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFillEventContactFromCustomer(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	dal.CreateCustomer(&persistency.Customer{ID: "dana", Name: "Dana Levi", Phone: "050-1234567", Email: "dana@example.com", Address: "Home"})
+
+	tests := []struct {
+		name      string
+		event     persistency.Event
+		wantPhone string
+		wantEmail string
+		wantErr   error
+	}{
+		{name: "no customer", event: persistency.Event{Phone: "03-1234567"}, wantPhone: "03-1234567"},
+		{name: "taken from the customer", event: persistency.Event{CustomerID: "dana"}, wantPhone: "050-1234567", wantEmail: "dana@example.com"},
+		{name: "own details kept", event: persistency.Event{CustomerID: "dana", Phone: "03-1234567", Email: "venue@example.com"}, wantPhone: "03-1234567", wantEmail: "venue@example.com"},
+		{name: "missing customer", event: persistency.Event{CustomerID: "nobody"}, wantErr: persistency.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			event.Address = "Garden"
+			err := service.fillEventContactFromCustomer(&event)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if event.Phone != tt.wantPhone || event.Email != tt.wantEmail {
+				t.Errorf("got phone %q and email %q, want %q and %q", event.Phone, event.Email, tt.wantPhone, tt.wantEmail)
+			}
+			if event.Address != "Garden" {
+				t.Errorf("the address of the event should be kept, got %q", event.Address)
+			}
+		})
+	}
+}
+
+func TestGetCustomerEvents(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	dal.CreateCustomer(&persistency.Customer{ID: "dana", Name: "Dana Levi"})
+	dal.CreateCustomer(&persistency.Customer{ID: "yossi", Name: "Yossi Mizrahi"})
+
+	deletedAt := time.Now()
+	for _, event := range []*persistency.Event{
+		{ID: "wedding", CustomerID: "dana"},
+		{ID: "birthday", CustomerID: "dana"},
+		{ID: "cancelled", CustomerID: "dana", DeletedAt: &deletedAt},
+		{ID: "bar-mitzvah", CustomerID: "yossi"},
+		{ID: "walk-in"},
+	} {
+		dal.CreateEvent(event)
+	}
+
+	events, err := service.GetCustomerEvents("dana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].ID != "wedding" || events[1].ID != "birthday" {
+		t.Errorf("expected the live events of the customer, got %+v", events)
+	}
+
+	if _, err := service.GetCustomerEvents("nobody"); !errors.Is(err, persistency.ErrNotFound) {
+		t.Errorf("the history of a missing customer should not be found, got %v", err)
+	}
+}
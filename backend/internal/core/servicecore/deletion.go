@@ -0,0 +1,89 @@
+package servicecore
+
+import (
+	persistency "flower-management/internal/persistency/contracts"
+	"time"
+)
+
+func (s *ServiceCore) RestoreFlower(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.DalInstance.RestoreFlower(id)
+}
+
+func (s *ServiceCore) RestoreProduct(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.DalInstance.RestoreProduct(id)
+}
+
+func (s *ServiceCore) RestoreEvent(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.DalInstance.RestoreEvent(id)
+}
+
+// PurgeDeleted permanently removes the flowers, products and events deleted more than olderThanDays
+// days ago and returns how many were removed.
+func (s *ServiceCore) PurgeDeleted(olderThanDays int) (int, error) {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return 0, err
+	}
+
+	if olderThanDays < 0 {
+		return 0, persistency.Invalid("days must not be negative, got %d", olderThanDays)
+	}
+
+	return s.DalInstance.PurgeDeleted(time.Now().UTC().AddDate(0, 0, -olderThanDays))
+}
+
+// getActiveFlower fails when the flower does not exist or is deleted
+func (s *ServiceCore) getActiveFlower(id string) (*persistency.Flower, error) {
+	flower, err := s.DalInstance.GetFlower(id)
+	if err != nil {
+		return nil, err
+	}
+	if flower == nil {
+		return nil, persistency.NotFound("flower with ID %s does not exist", id)
+	}
+	if flower.DeletedAt != nil {
+		return nil, persistency.Invalid("flower with ID %s is deleted", id)
+	}
+
+	return flower, nil
+}
+
+// getActiveProduct fails when the product does not exist or is deleted
+func (s *ServiceCore) getActiveProduct(id string) (*persistency.Product, error) {
+	product, err := s.DalInstance.GetProduct(id)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		return nil, persistency.NotFound("product with ID %s does not exist", id)
+	}
+	if product.DeletedAt != nil {
+		return nil, persistency.Invalid("product with ID %s is deleted", id)
+	}
+
+	return product, nil
+}
+
+// getActiveEvent fails when the event does not exist or is deleted
+func (s *ServiceCore) getActiveEvent(id string) (*persistency.Event, error) {
+	event, err := s.getEvent(id)
+	if err != nil {
+		return nil, err
+	}
+	if event.DeletedAt != nil {
+		return nil, persistency.Invalid("event with ID %s is deleted", id)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,113 @@
+package servicecore
+
+import (
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestSoftDeleteAndRestore(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	product := &persistency.Product{ID: "product", Name: "Bouquet"}
+	if err := dal.CreateProduct(product); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.DeleteProduct(product.ID); err != nil {
+		t.Fatal(err)
+	}
+	products, _, _ := service.GetFilteredProducts(&contracts.GetFilteredProductsRequest{})
+	if len(products) != 0 {
+		t.Errorf("deleted products should be hidden, got %d", len(products))
+	}
+	products, _, _ = service.GetFilteredProducts(&contracts.GetFilteredProductsRequest{IncludeDeleted: true})
+	if len(products) != 1 {
+		t.Errorf("deleted products should be listed on request, got %d", len(products))
+	}
+	if _, err := service.getActiveProduct(product.ID); err == nil {
+		t.Errorf("a deleted product should not be used")
+	}
+
+	if err := service.RestoreProduct(product.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.getActiveProduct(product.ID); err != nil {
+		t.Errorf("a restored product should be used: %v", err)
+	}
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	old := time.Now().UTC().AddDate(0, 0, -40)
+	recent := time.Now().UTC().AddDate(0, 0, -5)
+	dal.CreateFlower(&persistency.Flower{ID: "old", DeletedAt: &old}, &[]contracts.PackingOptions{})
+	dal.CreateFlower(&persistency.Flower{ID: "recent", DeletedAt: &recent}, &[]contracts.PackingOptions{})
+	dal.CreateFlower(&persistency.Flower{ID: "active"}, &[]contracts.PackingOptions{})
+
+	purged, err := service.PurgeDeleted(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("purged %d flowers, want 1", purged)
+	}
+	flowers, _, _ := service.GetFilteredFlowers(&contracts.GetFilteredFlowersRequest{IncludeDeleted: true})
+	if len(flowers) != 2 {
+		t.Errorf("%d flowers left, want 2", len(flowers))
+	}
+
+	if _, err := service.PurgeDeleted(-1); err == nil {
+		t.Errorf("negative days should be rejected")
+	}
+}
+
+func TestPurgeKeepsReferencedTombstones(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	eventID := newConfirmedEvent(t, dal)
+	if _, err := service.CreatePurchaseOrders(&contracts.CreatePurchaseOrdersRequest{EventID: eventID}); err != nil {
+		t.Fatal(err)
+	}
+
+	old := time.Now().UTC().AddDate(0, 0, -40)
+	product, _ := dal.GetProduct("bouquet")
+	product.DeletedAt = &old
+	flower, _ := dal.GetFlower("rose")
+	flower.DeletedAt = &old
+
+	// the live event still holds the bouquet, and the bouquet and the purchase order the roses
+	purged, err := service.PurgeDeleted(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 0 {
+		t.Errorf("referenced tombstones should be kept, purged %d", purged)
+	}
+	if products, _, _ := service.GetFilteredProducts(&contracts.GetFilteredProductsRequest{IncludeDeleted: true}); len(products) != 1 {
+		t.Errorf("the bouquet should be kept, got %d products", len(products))
+	}
+	if eventProducts, _ := dal.GetProductsFromEvent(eventID); len(eventProducts) != 1 {
+		t.Errorf("the event should keep its bouquets, got %+v", eventProducts)
+	}
+
+	// once the event goes, nothing references the bouquet and the roses anymore
+	event, _ := dal.GetEvent(eventID)
+	event.DeletedAt = &old
+	purged, err = service.PurgeDeleted(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 3 {
+		t.Errorf("the event, the bouquet and the roses should be purged, purged %d", purged)
+	}
+
+	records, _ := service.GetAuditRecords(&contracts.GetAuditRecordsRequest{EntityID: "rose"})
+	if len(records) == 0 || records[len(records)-1].Action != contracts.AuditActionPurge || records[len(records)-1].Before == nil {
+		t.Errorf("the purge should be recorded with the purged flower, got %+v", records)
+	}
+}
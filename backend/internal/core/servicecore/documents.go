@@ -0,0 +1,184 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	"flower-management/internal/pdf"
+	"fmt"
+	"sort"
+)
+
+const (
+	documentTitleSize = 18
+	documentTextSize  = 10
+	documentLabelX    = 90
+)
+
+// RenderEventQuotePDF renders the customer facing quote of an event.
+func (s *ServiceCore) RenderEventQuotePDF(req *contracts.GetEventQuoteRequest) ([]byte, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	event, err := s.DalInstance.GetEvent(req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.GetEventQuote(req)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.NewDocument()
+	doc.Heading("Quote", documentTitleSize)
+	doc.Space(6)
+	doc.KeyValue("Event", event.Name, documentLabelX, documentTextSize)
+	doc.KeyValue("Date", event.Date.Format("02/01/2006"), documentLabelX, documentTextSize)
+	doc.KeyValue("Address", event.Address, documentLabelX, documentTextSize)
+	if event.Phone != "" {
+		doc.KeyValue("Phone", event.Phone, documentLabelX, documentTextSize)
+	}
+	if event.Email != "" {
+		doc.KeyValue("Email", event.Email, documentLabelX, documentTextSize)
+	}
+	doc.Space(12)
+
+	columns := []pdf.Column{
+		{Title: "Product", X: 0, Width: 260},
+		{Title: "Quantity", X: 270, Width: 60, Align: pdf.AlignRight},
+		{Title: "Unit price", X: 340, Width: 70, Align: pdf.AlignRight},
+		{Title: "Total", X: 420, Width: 75, Align: pdf.AlignRight},
+	}
+	rows := make([][]string, 0, len(quote.LineItems))
+	for _, lineItem := range quote.LineItems {
+		rows = append(rows, []string{
+			lineItem.ProductName,
+			fmt.Sprint(lineItem.Quantity),
+			formatPrice(lineItem.UnitPrice),
+			formatPrice(lineItem.LineTotal),
+		})
+	}
+	doc.Table(columns, rows, documentTextSize)
+	doc.Rule()
+	doc.TotalRow(columns, []string{"Subtotal", "", "", formatPrice(quote.Subtotal)}, documentTextSize)
+	doc.TotalRow(columns, []string{fmt.Sprintf("Tax (%g%%)", quote.TaxPercent), "", "", formatPrice(quote.Tax)}, documentTextSize)
+	doc.TotalRow(columns, []string{"Total", "", "", formatPrice(quote.GrandTotal)}, documentTextSize)
+
+	return doc.Bytes(), nil
+}
+
+// RenderOrderSheetPDF renders the internal sheet of an event: the packages to order from every
+// supplier and the stems to pick for every flower.
+func (s *ServiceCore) RenderOrderSheetPDF(req *contracts.GetFlowersInEventRequest) ([]byte, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	event, err := s.DalInstance.GetEvent(req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	flowers, err := s.GetFlowersInEvent(req)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.NewDocument()
+	doc.Heading("Order sheet", documentTitleSize)
+	doc.Space(6)
+	doc.KeyValue("Event", event.Name, documentLabelX, documentTextSize)
+	doc.KeyValue("Date", event.Date.Format("02/01/2006"), documentLabelX, documentTextSize)
+	doc.KeyValue("Strategy", string(flowers.Strategy), documentLabelX, documentTextSize)
+	doc.KeyValue("Sourcing", string(flowers.Sourcing), documentLabelX, documentTextSize)
+	doc.Space(12)
+
+	doc.Heading("Packages to order", 12)
+	orderColumns := []pdf.Column{
+		{Title: "Supplier", X: 0, Width: 120},
+		{Title: "Flower", X: 125, Width: 130},
+		{Title: "Pack size", X: 260, Width: 50, Align: pdf.AlignRight},
+		{Title: "Packs", X: 315, Width: 45, Align: pdf.AlignRight},
+		{Title: "Pack price", X: 365, Width: 60, Align: pdf.AlignRight},
+		{Title: "Total", X: 430, Width: 65, Align: pdf.AlignRight},
+	}
+	orderRows := make([][]string, 0, len(flowers.Packages))
+	for _, flowerPackages := range flowers.Packages {
+		orderRows = append(orderRows, []string{
+			flowerPackages.SupplierName,
+			flowerPackages.FlowerName,
+			fmt.Sprint(flowerPackages.NumOfFlowersInPackage),
+			fmt.Sprint(flowerPackages.NumOfPackages),
+			formatPrice(flowerPackages.Price),
+			formatPrice(flowerPackages.Price * float64(flowerPackages.NumOfPackages)),
+		})
+	}
+	doc.Table(orderColumns, orderRows, documentTextSize)
+	doc.Rule()
+	doc.TotalRow(orderColumns, []string{"Total", "", "", fmt.Sprint(flowers.TotalPackages), "", formatPrice(flowers.TotalPrice)}, documentTextSize)
+	doc.Space(18)
+
+	doc.Heading("Stems to pick", 12)
+	pickColumns := []pdf.Column{
+		{Title: "Flower", X: 0, Width: 200},
+		{Title: "Required", X: 210, Width: 80, Align: pdf.AlignRight},
+		{Title: "From stock", X: 300, Width: 90, Align: pdf.AlignRight},
+		{Title: "Overbought", X: 400, Width: 95, Align: pdf.AlignRight},
+	}
+	doc.Table(pickColumns, pickRows(flowers), documentTextSize)
+	doc.Rule()
+	doc.TotalRow(pickColumns, []string{
+		"Total",
+		fmt.Sprint(flowers.TotalFlowersRequired),
+		fmt.Sprint(flowers.TotalFlowersFromStock),
+		fmt.Sprint(flowers.TotalFlowersOverbought),
+	}, documentTextSize)
+
+	return doc.Bytes(), nil
+}
+
+// pickRows lists every flower once, the per flower counts repeat on each of its package rows. Flowers
+// fully covered by stock have no package rows and are listed as picked from stock only.
+func pickRows(flowers *contracts.FlowersPackagesResponse) [][]string {
+	type pick struct {
+		flowerName string
+		row        []string
+	}
+
+	seen := make(map[string]bool)
+	var picks []pick
+	for _, flowerPackages := range flowers.Packages {
+		if seen[flowerPackages.FlowerID] {
+			continue
+		}
+		seen[flowerPackages.FlowerID] = true
+		picks = append(picks, pick{flowerPackages.FlowerName, []string{
+			flowerPackages.FlowerName,
+			fmt.Sprint(flowerPackages.NumOfFlowersRequired),
+			fmt.Sprint(flowerPackages.NumOfFlowersFromStock),
+			fmt.Sprint(flowerPackages.NumOfFlowersOverbought),
+		}})
+	}
+	for _, fromStock := range flowers.FromStock {
+		picks = append(picks, pick{fromStock.FlowerName, []string{
+			fromStock.FlowerName,
+			fmt.Sprint(fromStock.NumOfFlowersRequired),
+			fmt.Sprint(fromStock.NumOfFlowersFromStock),
+			"0",
+		}})
+	}
+	sort.SliceStable(picks, func(i, j int) bool {
+		return picks[i].flowerName < picks[j].flowerName
+	})
+
+	rows := make([][]string, 0, len(picks))
+	for _, pick := range picks {
+		rows = append(rows, pick.row)
+	}
+
+	return rows
+}
+
+func formatPrice(price float64) string {
+	return fmt.Sprintf("%.2f", price)
+}
@@ -0,0 +1,28 @@
+package servicecore
+
+import (
+	"reflect"
+	"testing"
+
+	"flower-management/contracts"
+)
+
+func TestPickRowsListFlowersFromStock(t *testing.T) {
+	rows := pickRows(&contracts.FlowersPackagesResponse{
+		Packages: []*contracts.FlowerPackages{
+			{FlowerID: "rose", FlowerName: "Rose", SupplierID: "a", NumOfFlowersRequired: 36, NumOfFlowersFromStock: 6, NumOfFlowersOverbought: 4},
+			{FlowerID: "rose", FlowerName: "Rose", SupplierID: "b", NumOfFlowersRequired: 36, NumOfFlowersFromStock: 6, NumOfFlowersOverbought: 4},
+		},
+		FromStock: []*contracts.FlowerFromStock{
+			{FlowerID: "lily", FlowerName: "Lily", NumOfFlowersRequired: 12, NumOfFlowersFromStock: 12},
+		},
+	})
+
+	want := [][]string{
+		{"Lily", "12", "12", "0"},
+		{"Rose", "36", "6", "4"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("got rows %v, want %v", rows, want)
+	}
+}
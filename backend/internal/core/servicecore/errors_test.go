@@ -0,0 +1,93 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestErrorKinds(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	event := &persistency.Event{ID: "event", Status: contracts.EventStatusInquiry}
+	if err := dal.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.CreateUser(&contracts.CreateUserRequest{Username: "dana", Password: "a password", Role: contracts.RoleOwner}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "missing event",
+			err:  service.TransitionEvent(&contracts.TransitionEventRequest{ID: "missing", Status: contracts.EventStatusQuoted}),
+			want: persistency.ErrNotFound,
+		},
+		{
+			name: "missing flower",
+			err: func() error {
+				_, err := service.GetFlower("missing")
+				return err
+			}(),
+			want: persistency.ErrNotFound,
+		},
+		{
+			name: "flower priced by a missing supplier",
+			err: func() error {
+				_, err := service.CreateFlower(&contracts.CreateFlowerRequest{Name: "Rose", PackingOptions: &[]contracts.PackingOptions{
+					{Quantity: 10, Price: 5, SupplierID: "1c5e0d57-33a4-4a4e-9d8b-8f2f5d7ad7f3"},
+				}})
+				return err
+			}(),
+			want: persistency.ErrNotFound,
+		},
+		{
+			name: "deleting a missing event",
+			err:  service.DeleteEvent("missing"),
+			want: persistency.ErrNotFound,
+		},
+		{
+			name: "forbidden transition",
+			err:  service.TransitionEvent(&contracts.TransitionEventRequest{ID: event.ID, Status: contracts.EventStatusClosed}),
+			want: persistency.ErrConflict,
+		},
+		{
+			name: "taken username",
+			err: func() error {
+				_, err := service.CreateUser(&contracts.CreateUserRequest{Username: "dana", Password: "another one", Role: contracts.RoleOwner})
+				return err
+			}(),
+			want: persistency.ErrConflict,
+		},
+		{
+			name: "negative days",
+			err: func() error {
+				_, err := service.PurgeDeleted(-1)
+				return err
+			}(),
+			want: persistency.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.want) {
+			t.Errorf("%s: got %v, want an error of kind %v", tt.name, tt.err, tt.want)
+		}
+	}
+}
+
+func TestInvalidCursorBlamesTheCursor(t *testing.T) {
+	_, err := persistency.DecodeCursor("not a cursor", nil)
+
+	var persistencyErr *persistency.Error
+	if !errors.As(err, &persistencyErr) || persistencyErr.Fields["cursor"] == "" {
+		t.Errorf("an invalid cursor should be a validation error of the cursor, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// eventStatusRules describes what is allowed while an event is in a status
+type eventStatusRules struct {
+	transitions      []contracts.EventStatus
+	productsEditable bool
+	purchasable      bool
+}
+
+var eventStatuses = map[contracts.EventStatus]eventStatusRules{
+	contracts.EventStatusInquiry: {
+		transitions:      []contracts.EventStatus{contracts.EventStatusQuoted, contracts.EventStatusCancelled},
+		productsEditable: true,
+	},
+	contracts.EventStatusQuoted: {
+		transitions:      []contracts.EventStatus{contracts.EventStatusConfirmed, contracts.EventStatusInquiry, contracts.EventStatusCancelled},
+		productsEditable: true,
+	},
+	contracts.EventStatusConfirmed: {
+		transitions:      []contracts.EventStatus{contracts.EventStatusInProduction, contracts.EventStatusCancelled},
+		productsEditable: true,
+		purchasable:      true,
+	},
+	contracts.EventStatusInProduction: {
+		transitions: []contracts.EventStatus{contracts.EventStatusDelivered},
+	},
+	contracts.EventStatusDelivered: {
+		transitions: []contracts.EventStatus{contracts.EventStatusClosed},
+	},
+	contracts.EventStatusClosed:    {},
+	contracts.EventStatusCancelled: {},
+}
+
+// TransitionEvent moves an event along its lifecycle.
+func (s *ServiceCore) TransitionEvent(req *contracts.TransitionEventRequest) error {
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return err
+	}
+
+	event, err := s.getActiveEvent(req.ID)
+	if err != nil {
+		return err
+	}
+
+	if !canTransitionEvent(event.Status, req.Status) {
+		return persistency.Conflict("event with ID %s cannot move from %s to %s", req.ID, event.Status, req.Status)
+	}
+
+	return s.DalInstance.UpdateEventStatus(req.ID, req.Status)
+}
+
+func canTransitionEvent(from, to contracts.EventStatus) bool {
+	for _, allowed := range eventStatuses[from].transitions {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// checkEventProductsEditable fails once the event went into production
+func (s *ServiceCore) checkEventProductsEditable(eventID string) error {
+	event, err := s.getActiveEvent(eventID)
+	if err != nil {
+		return err
+	}
+
+	if !eventStatuses[event.Status].productsEditable {
+		return persistency.Conflict("products of event with ID %s cannot be edited while it is %s", eventID, event.Status)
+	}
+
+	return nil
+}
+
+// checkProductFlowersEditable fails while an event using the product is in production, changing its
+// flowers would change what is being made
+func (s *ServiceCore) checkProductFlowersEditable(productID string) error {
+	events, _, err := s.DalInstance.GetFilteredEvents(&contracts.GetFilteredEventsRequest{
+		Statuses: []contracts.EventStatus{contracts.EventStatusInProduction},
+	})
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	eventIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+	eventProducts, err := s.DalInstance.GetProductsFromEvents(eventIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, eventProduct := range eventProducts {
+		if eventProduct.ProductID == productID {
+			return persistency.Conflict("flowers of product with ID %s cannot be edited while event with ID %s is %s",
+				productID, eventProduct.EventID, contracts.EventStatusInProduction)
+		}
+	}
+
+	return nil
+}
+
+// checkEventPurchasable fails unless the event is confirmed
+func (s *ServiceCore) checkEventPurchasable(eventID string) error {
+	event, err := s.getActiveEvent(eventID)
+	if err != nil {
+		return err
+	}
+
+	if !eventStatuses[event.Status].purchasable {
+		return persistency.Conflict("purchase orders cannot be created for event with ID %s while it is %s", eventID, event.Status)
+	}
+
+	return nil
+}
+
+func (s *ServiceCore) getEvent(id string) (*persistency.Event, error) {
+	event, err := s.DalInstance.GetEvent(id)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, persistency.NotFound("event with ID %s does not exist", id)
+	}
+
+	return event, nil
+}
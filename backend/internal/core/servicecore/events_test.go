@@ -0,0 +1,144 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestCanTransitionEvent(t *testing.T) {
+	tests := []struct {
+		from, to contracts.EventStatus
+		want     bool
+	}{
+		{contracts.EventStatusInquiry, contracts.EventStatusQuoted, true},
+		{contracts.EventStatusInquiry, contracts.EventStatusConfirmed, false},
+		{contracts.EventStatusQuoted, contracts.EventStatusConfirmed, true},
+		{contracts.EventStatusConfirmed, contracts.EventStatusInProduction, true},
+		{contracts.EventStatusInProduction, contracts.EventStatusCancelled, false},
+		{contracts.EventStatusDelivered, contracts.EventStatusClosed, true},
+		{contracts.EventStatusClosed, contracts.EventStatusInquiry, false},
+		{contracts.EventStatusCancelled, contracts.EventStatusInquiry, false},
+	}
+
+	for _, tt := range tests {
+		if got := canTransitionEvent(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransitionEvent(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestEventStatusRules(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	event := &persistency.Event{ID: "event", Status: contracts.EventStatusQuoted}
+	if err := dal.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.checkEventPurchasable(event.ID); err == nil {
+		t.Errorf("a quoted event should not feed purchase orders")
+	}
+	if err := service.TransitionEvent(&contracts.TransitionEventRequest{ID: event.ID, Status: contracts.EventStatusConfirmed}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.checkEventPurchasable(event.ID); err != nil {
+		t.Errorf("a confirmed event should feed purchase orders: %v", err)
+	}
+	if err := service.checkEventProductsEditable(event.ID); err != nil {
+		t.Errorf("products of a confirmed event should be editable: %v", err)
+	}
+
+	if err := service.TransitionEvent(&contracts.TransitionEventRequest{ID: event.ID, Status: contracts.EventStatusInProduction}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.checkEventProductsEditable(event.ID); err == nil {
+		t.Errorf("products of an event in production should not be editable")
+	}
+	if err := service.TransitionEvent(&contracts.TransitionEventRequest{ID: event.ID, Status: contracts.EventStatusCancelled}); err == nil {
+		t.Errorf("an event in production should not be cancellable")
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, event := range []*persistency.Event{
+		{ID: "a", Name: "Summer wedding", Date: day.Add(15 * time.Hour), Status: contracts.EventStatusQuoted},
+		{ID: "b", Name: "Wedding brunch", Date: day.AddDate(0, 0, 1), Status: contracts.EventStatusConfirmed},
+		{ID: "c", Name: "Gala", Date: day.AddDate(0, 0, 2), Status: contracts.EventStatusInquiry},
+	} {
+		dal.CreateEvent(event)
+	}
+
+	tests := []struct {
+		name string
+		req  contracts.GetFilteredEventsRequest
+		want int
+	}{
+		{"contains", contracts.GetFilteredEventsRequest{Name: "wedding"}, 2},
+		{"prefix", contracts.GetFilteredEventsRequest{Name: "wedding", Match: contracts.MatchPrefix}, 1},
+		{"exact", contracts.GetFilteredEventsRequest{Name: "gala", Match: contracts.MatchExact}, 1},
+		{"day", contracts.GetFilteredEventsRequest{Date: day}, 1},
+		{"range", contracts.GetFilteredEventsRequest{DateFrom: day.AddDate(0, 0, 1), DateTo: day.AddDate(0, 0, 3)}, 2},
+		{"statuses", contracts.GetFilteredEventsRequest{Statuses: []contracts.EventStatus{contracts.EventStatusQuoted, contracts.EventStatusInquiry}}, 2},
+		{"ids", contracts.GetFilteredEventsRequest{IDs: []string{"b"}}, 1},
+	}
+
+	for _, tt := range tests {
+		events, _, err := service.GetFilteredEvents(&tt.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != tt.want {
+			t.Errorf("%s: got %d events, want %d", tt.name, len(events), tt.want)
+		}
+	}
+}
+
+func TestProductFlowersLockedInProduction(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	eventID := newConfirmedEvent(t, dal)
+	dal.CreateProduct(&persistency.Product{ID: "centerpiece", Name: "Centerpiece"})
+
+	editFlowers := func(productID string) error {
+		return service.EditFlowersInProduct(&contracts.AddFlowersToProductRequest{ProductID: productID, Flowers: &[]contracts.FlowerInProduct{
+			{FlowerID: "rose", NumOfFlowers: 10},
+		}})
+	}
+	addFlowers := func(productID string) error {
+		return service.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: productID, Flowers: &[]contracts.FlowerInProduct{
+			{FlowerID: "rose", NumOfFlowers: 1},
+		}})
+	}
+
+	if err := editFlowers("bouquet"); err != nil {
+		t.Errorf("flowers of a product of a confirmed event should be editable: %v", err)
+	}
+
+	if err := service.TransitionEvent(&contracts.TransitionEventRequest{ID: eventID, Status: contracts.EventStatusInProduction}); err != nil {
+		t.Fatal(err)
+	}
+	if err := editFlowers("bouquet"); !errors.Is(err, persistency.ErrConflict) {
+		t.Errorf("flowers of a product in production should not be edited, got %v", err)
+	}
+	if err := addFlowers("bouquet"); !errors.Is(err, persistency.ErrConflict) {
+		t.Errorf("flowers should not be added to a product in production, got %v", err)
+	}
+	if err := addFlowers("centerpiece"); err != nil {
+		t.Errorf("a product no event in production uses should be editable: %v", err)
+	}
+
+	if err := service.TransitionEvent(&contracts.TransitionEventRequest{ID: eventID, Status: contracts.EventStatusDelivered}); err != nil {
+		t.Fatal(err)
+	}
+	if err := editFlowers("bouquet"); err != nil {
+		t.Errorf("flowers of a product should be editable once the event is delivered: %v", err)
+	}
+}
@@ -0,0 +1,12 @@
+package servicecore
+
+import (
+	"context"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// CheckHealth checks the database the service depends on. It needs no permission, the orchestrator
+// calls it without a token.
+func (s *ServiceCore) CheckHealth(ctx context.Context) (*persistency.Health, error) {
+	return s.DalInstance.CheckHealth(ctx)
+}
@@ -0,0 +1,195 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	"math"
+	"sort"
+
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// maxPackedFlowers bounds the flower counts calcBestOption goes through, it allocates a few slices of that size
+const maxPackedFlowers = 1000000
+
+type packingResult struct {
+	// packages maps every chosen packing option to the number of packages to buy
+	packages      map[*persistency.FlowerPackageOptions]int
+	totalPrice    float64
+	totalPackages int
+	overbought    int
+}
+
+type packingCost struct {
+	price    float64
+	packages int
+}
+
+// calcBestOption returns the best combination of packing options covering numOfFlowers for the given strategy.
+// It runs an unbounded knapsack over every flower count from 0 up to numOfFlowers + overbuyLimit and picks
+// the best reachable count that is not below numOfFlowers. A negative overbuyLimit means no limit.
+func calcBestOption(numOfFlowers, overbuyLimit int, strategy contracts.PackingStrategy, packingOptions []*persistency.FlowerPackageOptions) (*packingResult, error) {
+	if numOfFlowers <= 0 {
+		return &packingResult{packages: map[*persistency.FlowerPackageOptions]int{}}, nil
+	}
+
+	// keep the cheapest option per package size and drop invalid options,
+	// equally priced options of different suppliers are told apart by the supplier ID
+	cheapest := make(map[int]*persistency.FlowerPackageOptions)
+	maxPackage := 0
+	for _, option := range packingOptions {
+		if option.NumOfFlowers <= 0 {
+			continue
+		}
+		current, ok := cheapest[option.NumOfFlowers]
+		if !ok || option.Price < current.Price || (option.Price == current.Price && option.SupplierID < current.SupplierID) {
+			cheapest[option.NumOfFlowers] = option
+		}
+		if option.NumOfFlowers > maxPackage {
+			maxPackage = option.NumOfFlowers
+		}
+	}
+	if len(cheapest) == 0 {
+		return nil, persistency.Invalid("no packing options available")
+	}
+
+	// an optimal packing never overbuys by a whole package, so maxPackage-1 is always enough
+	limit := maxPackage - 1
+	if overbuyLimit >= 0 && overbuyLimit < limit {
+		limit = overbuyLimit
+	}
+	total := numOfFlowers + limit
+	if total > maxPackedFlowers {
+		return nil, persistency.Invalid("cannot pack more than %d flowers at once, got %d", maxPackedFlowers, total)
+	}
+
+	// per flower count the fewest packages matter only when they are the objective
+	lessForCount := func(a, b packingCost) bool {
+		if strategy == contracts.PackingStrategyFewestPackages && a.packages != b.packages {
+			return a.packages < b.packages
+		}
+		return a.price < b.price
+	}
+
+	// cost[n] is the best cost for exactly n flowers, lastPackage[n] the package used to reach it
+	cost := make([]packingCost, total+1)
+	reachable := make([]bool, total+1)
+	lastPackage := make([]int, total+1)
+	reachable[0] = true
+	for n := 1; n <= total; n++ {
+		for size, option := range cheapest {
+			if size > n || !reachable[n-size] {
+				continue
+			}
+			candidate := packingCost{price: cost[n-size].price + option.Price, packages: cost[n-size].packages + 1}
+			if !reachable[n] || lessForCount(candidate, cost[n]) || (!lessForCount(cost[n], candidate) && size > lastPackage[n]) {
+				cost[n] = candidate
+				reachable[n] = true
+				lastPackage[n] = size
+			}
+		}
+	}
+
+	// counts are visited in increasing order, so ties are always won by the smaller overbuy
+	best := -1
+	for n := numOfFlowers; n <= total; n++ {
+		if !reachable[n] {
+			continue
+		}
+		if best == -1 {
+			best = n
+			if strategy == contracts.PackingStrategyLeastWaste {
+				break
+			}
+			continue
+		}
+		if lessForCount(cost[n], cost[best]) {
+			best = n
+		}
+	}
+	if best == -1 {
+		return nil, persistency.Invalid("no packing covers %d flowers within an overbuy limit of %d", numOfFlowers, limit)
+	}
+
+	result := &packingResult{
+		packages:      make(map[*persistency.FlowerPackageOptions]int),
+		totalPrice:    cost[best].price,
+		totalPackages: cost[best].packages,
+		overbought:    best - numOfFlowers,
+	}
+	for n := best; n > 0; n -= lastPackage[n] {
+		result.packages[cheapest[lastPackage[n]]]++
+	}
+
+	return result, nil
+}
+
+// overbuyLimitForStrategy narrows the configured overbuy limit when the strategy caps the waste.
+func overbuyLimitForStrategy(numOfFlowers, overbuyLimit int, strategy contracts.PackingStrategy, maxWastePercent float64) int {
+	if strategy != contracts.PackingStrategyCheapestWithinWaste {
+		return overbuyLimit
+	}
+
+	wasteLimit := int(math.Floor(float64(numOfFlowers)*maxWastePercent/100 + 1e-9))
+	if overbuyLimit >= 0 && overbuyLimit < wasteLimit {
+		return overbuyLimit
+	}
+	return wasteLimit
+}
+
+// betterPacking reports whether packing a is preferred over packing b by the strategy.
+func betterPacking(strategy contracts.PackingStrategy, a, b *packingResult) bool {
+	switch strategy {
+	case contracts.PackingStrategyLeastWaste:
+		if a.overbought != b.overbought {
+			return a.overbought < b.overbought
+		}
+	case contracts.PackingStrategyFewestPackages:
+		if a.totalPackages != b.totalPackages {
+			return a.totalPackages < b.totalPackages
+		}
+	}
+	if a.totalPrice != b.totalPrice {
+		return a.totalPrice < b.totalPrice
+	}
+	return a.overbought < b.overbought
+}
+
+// packFlower packs numOfFlowers from the packing options according to the sourcing mode.
+// When the flower comes from a single supplier every supplier is packed on its own and the best
+// packing wins, ties going to the supplier with the lower ID.
+func packFlower(numOfFlowers, overbuyLimit int, strategy contracts.PackingStrategy, sourcing contracts.SourcingMode, packingOptions []*persistency.FlowerPackageOptions) (*packingResult, error) {
+	if sourcing == contracts.SourcingModeSplitSuppliers {
+		return calcBestOption(numOfFlowers, overbuyLimit, strategy, packingOptions)
+	}
+
+	optionsBySupplier := make(map[string][]*persistency.FlowerPackageOptions)
+	for _, option := range packingOptions {
+		optionsBySupplier[option.SupplierID] = append(optionsBySupplier[option.SupplierID], option)
+	}
+	supplierIDs := make([]string, 0, len(optionsBySupplier))
+	for supplierID := range optionsBySupplier {
+		supplierIDs = append(supplierIDs, supplierID)
+	}
+	sort.Strings(supplierIDs)
+
+	var best *packingResult
+	var lastErr error
+	for _, supplierID := range supplierIDs {
+		res, err := calcBestOption(numOfFlowers, overbuyLimit, strategy, optionsBySupplier[supplierID])
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if best == nil || betterPacking(strategy, res, best) {
+			best = res
+		}
+	}
+	if best == nil {
+		if lastErr == nil {
+			lastErr = persistency.Invalid("no packing options available")
+		}
+		return nil, lastErr
+	}
+
+	return best, nil
+}
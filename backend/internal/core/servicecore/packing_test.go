@@ -0,0 +1,325 @@
+package servicecore
+
+import (
+	"errors"
+	"flower-management/contracts"
+	"math"
+	"testing"
+
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func options(sizesAndPrices ...float64) []*persistency.FlowerPackageOptions {
+	var packingOptions []*persistency.FlowerPackageOptions
+	for i := 0; i+1 < len(sizesAndPrices); i += 2 {
+		packingOptions = append(packingOptions, &persistency.FlowerPackageOptions{
+			FlowerID:     "flower",
+			NumOfFlowers: int(sizesAndPrices[i]),
+			Price:        sizesAndPrices[i+1],
+		})
+	}
+	return packingOptions
+}
+
+type bruteForceResult struct {
+	price    float64
+	packages int
+	overbuy  int
+}
+
+// better orders two packings by the strategy objective; ties go to the cheaper, then the smaller overbuy.
+func better(strategy contracts.PackingStrategy, a, b bruteForceResult) bool {
+	samePrice := math.Abs(a.price-b.price) < 1e-9
+	switch strategy {
+	case contracts.PackingStrategyLeastWaste:
+		if a.overbuy != b.overbuy {
+			return a.overbuy < b.overbuy
+		}
+	case contracts.PackingStrategyFewestPackages:
+		if a.packages != b.packages {
+			return a.packages < b.packages
+		}
+	}
+	if !samePrice {
+		return a.price < b.price
+	}
+	return a.overbuy < b.overbuy
+}
+
+// bruteForce tries every combination of package counts and returns the best one for the strategy.
+func bruteForce(numOfFlowers, overbuyLimit int, strategy contracts.PackingStrategy, packingOptions []*persistency.FlowerPackageOptions) (bruteForceResult, bool) {
+	maxPackage := 0
+	for _, option := range packingOptions {
+		if option.NumOfFlowers > maxPackage {
+			maxPackage = option.NumOfFlowers
+		}
+	}
+	limit := maxPackage - 1
+	if overbuyLimit >= 0 && overbuyLimit < limit {
+		limit = overbuyLimit
+	}
+	total := numOfFlowers + limit
+
+	var best bruteForceResult
+	found := false
+	var walk func(i, flowers, packages int, price float64)
+	walk = func(i, flowers, packages int, price float64) {
+		if i == len(packingOptions) {
+			if flowers < numOfFlowers || flowers > total {
+				return
+			}
+			candidate := bruteForceResult{price: price, packages: packages, overbuy: flowers - numOfFlowers}
+			if !found || better(strategy, candidate, best) {
+				best, found = candidate, true
+			}
+			return
+		}
+		option := packingOptions[i]
+		for count := 0; flowers+count*option.NumOfFlowers <= total; count++ {
+			walk(i+1, flowers+count*option.NumOfFlowers, packages+count, price+float64(count)*option.Price)
+		}
+	}
+	walk(0, 0, 0, 0)
+
+	return best, found
+}
+
+func TestCalcBestOption(t *testing.T) {
+	tests := []struct {
+		name           string
+		strategy       contracts.PackingStrategy
+		numOfFlowers   int
+		overbuyLimit   int
+		packingOptions []*persistency.FlowerPackageOptions
+		wantErr        bool
+	}{
+		{name: "exact single package", numOfFlowers: 10, overbuyLimit: -1, packingOptions: options(10, 50)},
+		{name: "zero remainder on smaller package", numOfFlowers: 20, overbuyLimit: -1, packingOptions: options(25, 60, 10, 30)},
+		{name: "greedy is more expensive", numOfFlowers: 30, overbuyLimit: -1, packingOptions: options(25, 100, 10, 20)},
+		{name: "overbuy is cheaper", numOfFlowers: 9, overbuyLimit: -1, packingOptions: options(10, 20, 1, 5)},
+		{name: "overbuy limit forbids cheap surplus", numOfFlowers: 9, overbuyLimit: 0, packingOptions: options(10, 20, 1, 5)},
+		{name: "mixed sizes", numOfFlowers: 47, overbuyLimit: -1, packingOptions: options(20, 35, 12, 22, 5, 10, 1, 2.5)},
+		{name: "bulk discount", numOfFlowers: 101, overbuyLimit: -1, packingOptions: options(50, 40, 25, 25, 10, 12)},
+		{name: "fractional prices", numOfFlowers: 33, overbuyLimit: 5, packingOptions: options(7, 9.99, 4, 5.49, 3, 4.25)},
+		{name: "duplicate size keeps cheapest", numOfFlowers: 15, overbuyLimit: -1, packingOptions: options(5, 10, 5, 8, 3, 6)},
+		{name: "least waste prefers exact cover", strategy: contracts.PackingStrategyLeastWaste, numOfFlowers: 30, overbuyLimit: -1, packingOptions: options(25, 50, 10, 40)},
+		{name: "least waste falls back to smallest surplus", strategy: contracts.PackingStrategyLeastWaste, numOfFlowers: 13, overbuyLimit: -1, packingOptions: options(10, 20, 4, 12)},
+		{name: "fewest packages", strategy: contracts.PackingStrategyFewestPackages, numOfFlowers: 30, overbuyLimit: -1, packingOptions: options(25, 100, 10, 20)},
+		{name: "fewest packages breaks ties by price", strategy: contracts.PackingStrategyFewestPackages, numOfFlowers: 20, overbuyLimit: -1, packingOptions: options(20, 50, 12, 20, 10, 25)},
+		{name: "no flowers", numOfFlowers: 0, overbuyLimit: -1, packingOptions: options(10, 50)},
+		{name: "no packing options", numOfFlowers: 5, overbuyLimit: -1, packingOptions: nil, wantErr: true},
+		{name: "infeasible within overbuy limit", numOfFlowers: 3, overbuyLimit: 2, packingOptions: options(10, 50), wantErr: true},
+		{name: "too many flowers", numOfFlowers: maxPackedFlowers + 1, overbuyLimit: 0, packingOptions: options(10, 50), wantErr: true},
+		{name: "package too large to pack around", numOfFlowers: 5, overbuyLimit: -1, packingOptions: options(2*maxPackedFlowers, 50), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := tt.strategy
+			if strategy == "" {
+				strategy = contracts.PackingStrategyCheapest
+			}
+			res, err := calcBestOption(tt.numOfFlowers, tt.overbuyLimit, strategy, tt.packingOptions)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", res)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			checkAgainstBruteForce(t, tt.numOfFlowers, tt.overbuyLimit, strategy, tt.packingOptions, res)
+		})
+	}
+}
+
+func TestCalcBestOptionExhaustive(t *testing.T) {
+	optionSets := [][]*persistency.FlowerPackageOptions{
+		options(10, 50),
+		options(10, 30, 25, 60),
+		options(3, 5, 5, 8, 7, 11),
+		options(1, 3, 6, 10, 9, 14, 20, 28),
+		options(4, 0, 6, 1),
+	}
+
+	strategies := []contracts.PackingStrategy{
+		contracts.PackingStrategyCheapest,
+		contracts.PackingStrategyLeastWaste,
+		contracts.PackingStrategyFewestPackages,
+	}
+
+	for _, packingOptions := range optionSets {
+		for _, strategy := range strategies {
+			for _, overbuyLimit := range []int{-1, 0, 2} {
+				for numOfFlowers := 0; numOfFlowers <= 60; numOfFlowers++ {
+					res, err := calcBestOption(numOfFlowers, overbuyLimit, strategy, packingOptions)
+					_, found := bruteForce(numOfFlowers, overbuyLimit, strategy, packingOptions)
+					if numOfFlowers > 0 && !found {
+						if err == nil {
+							t.Fatalf("%s, %d flowers, limit %d: expected an error, got %+v", strategy, numOfFlowers, overbuyLimit, res)
+						}
+						continue
+					}
+					if err != nil {
+						t.Fatalf("%s, %d flowers, limit %d: unexpected error: %v", strategy, numOfFlowers, overbuyLimit, err)
+					}
+					checkAgainstBruteForce(t, numOfFlowers, overbuyLimit, strategy, packingOptions, res)
+				}
+			}
+		}
+	}
+}
+
+func checkAgainstBruteForce(t *testing.T, numOfFlowers, overbuyLimit int, strategy contracts.PackingStrategy, packingOptions []*persistency.FlowerPackageOptions, res *packingResult) {
+	t.Helper()
+
+	flowers := 0
+	packages := 0
+	price := 0.0
+	for option, numOfPackages := range res.packages {
+		flowers += option.NumOfFlowers * numOfPackages
+		packages += numOfPackages
+		price += option.Price * float64(numOfPackages)
+	}
+	if flowers < numOfFlowers {
+		t.Fatalf("%d flowers: packing %v covers only %d flowers", numOfFlowers, res.packages, flowers)
+	}
+	if flowers-numOfFlowers != res.overbought {
+		t.Fatalf("%d flowers: reported overbuy %d, packing %v overbuys %d", numOfFlowers, res.overbought, res.packages, flowers-numOfFlowers)
+	}
+	if math.Abs(price-res.totalPrice) > 1e-9 {
+		t.Fatalf("%d flowers: reported price %v, packing %v costs %v", numOfFlowers, res.totalPrice, res.packages, price)
+	}
+	if packages != res.totalPackages {
+		t.Fatalf("%d flowers: reported %d packages, packing %v has %d", numOfFlowers, res.totalPackages, res.packages, packages)
+	}
+	if numOfFlowers == 0 {
+		return
+	}
+
+	want, _ := bruteForce(numOfFlowers, overbuyLimit, strategy, packingOptions)
+	got := bruteForceResult{price: res.totalPrice, packages: res.totalPackages, overbuy: res.overbought}
+	if better(strategy, want, got) || better(strategy, got, want) {
+		t.Fatalf("%s, %d flowers: got %+v (%v), brute force found %+v", strategy, numOfFlowers, got, res.packages, want)
+	}
+}
+
+func TestOverbuyLimitForStrategy(t *testing.T) {
+	tests := []struct {
+		name            string
+		numOfFlowers    int
+		overbuyLimit    int
+		strategy        contracts.PackingStrategy
+		maxWastePercent float64
+		want            int
+	}{
+		{name: "other strategies keep the configured limit", numOfFlowers: 100, overbuyLimit: 7, strategy: contracts.PackingStrategyCheapest, maxWastePercent: 1, want: 7},
+		{name: "waste percentage without configured limit", numOfFlowers: 100, overbuyLimit: -1, strategy: contracts.PackingStrategyCheapestWithinWaste, maxWastePercent: 10, want: 10},
+		{name: "waste percentage rounds down", numOfFlowers: 45, overbuyLimit: -1, strategy: contracts.PackingStrategyCheapestWithinWaste, maxWastePercent: 10, want: 4},
+		{name: "configured limit is stricter", numOfFlowers: 100, overbuyLimit: 3, strategy: contracts.PackingStrategyCheapestWithinWaste, maxWastePercent: 10, want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := overbuyLimitForStrategy(tt.numOfFlowers, tt.overbuyLimit, tt.strategy, tt.maxWastePercent)
+			if got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPackFlowerSourcing(t *testing.T) {
+	packingOptions := []*persistency.FlowerPackageOptions{
+		{FlowerID: "flower", SupplierID: "a", NumOfFlowers: 10, Price: 20},
+		{FlowerID: "flower", SupplierID: "a", NumOfFlowers: 3, Price: 9},
+		{FlowerID: "flower", SupplierID: "b", NumOfFlowers: 10, Price: 25},
+		{FlowerID: "flower", SupplierID: "b", NumOfFlowers: 3, Price: 5},
+	}
+
+	tests := []struct {
+		name          string
+		sourcing      contracts.SourcingMode
+		numOfFlowers  int
+		wantPrice     float64
+		wantSuppliers []string
+	}{
+		{name: "single supplier picks the cheapest one", sourcing: contracts.SourcingModeCheapestSupplier, numOfFlowers: 13, wantPrice: 25, wantSuppliers: []string{"b"}},
+		{name: "single supplier prefers lower id on ties", sourcing: contracts.SourcingModeCheapestSupplier, numOfFlowers: 10, wantPrice: 20, wantSuppliers: []string{"a"}},
+		{name: "split mixes suppliers", sourcing: contracts.SourcingModeSplitSuppliers, numOfFlowers: 13, wantPrice: 25, wantSuppliers: []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := packFlower(tt.numOfFlowers, -1, contracts.PackingStrategyCheapest, tt.sourcing, packingOptions)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if math.Abs(res.totalPrice-tt.wantPrice) > 1e-9 {
+				t.Fatalf("got price %v, want %v", res.totalPrice, tt.wantPrice)
+			}
+			suppliers := make(map[string]bool)
+			for option := range res.packages {
+				suppliers[option.SupplierID] = true
+			}
+			if len(suppliers) != len(tt.wantSuppliers) {
+				t.Fatalf("got suppliers %v, want %v", suppliers, tt.wantSuppliers)
+			}
+			for _, supplierID := range tt.wantSuppliers {
+				if !suppliers[supplierID] {
+					t.Fatalf("got suppliers %v, want %v", suppliers, tt.wantSuppliers)
+				}
+			}
+		})
+	}
+}
+
+func TestFlowersInEventCountEveryProductQuantity(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{{Quantity: 10, Price: 5}})
+	dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bouquet"})
+	dal.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: "bouquet", Flowers: &[]contracts.FlowerInProduct{
+		{FlowerID: "rose", NumOfFlowers: 12},
+	}})
+	dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Status: contracts.EventStatusInquiry})
+	dal.AddProductsToEvent(&contracts.AddProductsToEventRequest{EventID: "wedding", Products: &[]contracts.ProductInEvent{
+		{ProductID: "bouquet", Quantity: 3},
+	}})
+
+	flowers, err := service.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{EventID: "wedding"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flowers.TotalFlowersRequired != 36 {
+		t.Errorf("three bouquets of 12 roses need 36 roses, got %d", flowers.TotalFlowersRequired)
+	}
+	if flowers.TotalPackages != 4 || flowers.TotalPrice != 20 {
+		t.Errorf("36 roses should take 4 packages of 10 for 20, got %d packages for %v", flowers.TotalPackages, flowers.TotalPrice)
+	}
+}
+
+func TestFlowersInEventFromMissingSupplierIsNotFound(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{
+		{Quantity: 10, Price: 5, SupplierID: "1c5e0d57-33a4-4a4e-9d8b-8f2f5d7ad7f3"},
+	})
+	dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bouquet"})
+	dal.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: "bouquet", Flowers: &[]contracts.FlowerInProduct{
+		{FlowerID: "rose", NumOfFlowers: 10},
+	}})
+	dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Status: contracts.EventStatusInquiry})
+	dal.AddProductsToEvent(&contracts.AddProductsToEventRequest{EventID: "wedding", Products: &[]contracts.ProductInEvent{
+		{ProductID: "bouquet", Quantity: 1},
+	}})
+
+	_, err := service.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{EventID: "wedding"})
+	if !errors.Is(err, persistency.ErrNotFound) {
+		t.Errorf("packing options of a missing supplier should not be found, got %v", err)
+	}
+}
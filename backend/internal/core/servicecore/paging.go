@@ -0,0 +1,17 @@
+package servicecore
+
+import "flower-management/contracts"
+
+// Lists are returned one page at a time, pages hold defaultPageLimit rows unless asked otherwise
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+func withPageLimit(page contracts.PageRequest) contracts.PageRequest {
+	if page.Limit <= 0 {
+		page.Limit = defaultPageLimit
+	}
+	page.Limit = min(page.Limit, maxPageLimit)
+	return page
+}
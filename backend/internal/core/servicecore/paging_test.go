@@ -0,0 +1,67 @@
+package servicecore
+
+import (
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestEventPages(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, event := range []*persistency.Event{
+		{ID: "a", Name: "Wedding", Date: day},
+		{ID: "b", Name: "Gala", Date: day.AddDate(0, 0, 2)},
+		{ID: "c", Name: "Brunch", Date: day},
+		{ID: "d", Name: "Funeral", Date: day.AddDate(0, 0, 1)},
+		{ID: "e", Name: "Launch", Date: day.AddDate(0, 0, 3)},
+	} {
+		dal.CreateEvent(event)
+	}
+
+	sort := []contracts.SortField{{Field: "date", Descending: true}, {Field: "name"}}
+	var got []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+		events, page, err := service.GetFilteredEvents(&contracts.GetFilteredEventsRequest{
+			Page: contracts.PageRequest{Limit: 2, Cursor: cursor, Sort: sort},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != 5 {
+			t.Errorf("total = %d, want 5", page.Total)
+		}
+		for _, event := range events {
+			got = append(got, event.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	want := []string{"e", "b", "d", "c", "a"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	_, _, err := service.GetFilteredEvents(&contracts.GetFilteredEventsRequest{
+		Page: contracts.PageRequest{Sort: []contracts.SortField{{Field: "description"}}},
+	})
+	if err == nil {
+		t.Errorf("sorting by an unknown field should fail")
+	}
+}
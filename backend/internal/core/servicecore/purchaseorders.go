@@ -0,0 +1,146 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// purchaseOrderTransitions lists the statuses a purchase order may move to from each status
+var purchaseOrderTransitions = map[contracts.PurchaseOrderStatus][]contracts.PurchaseOrderStatus{
+	contracts.PurchaseOrderStatusDraft:     {contracts.PurchaseOrderStatusSent},
+	contracts.PurchaseOrderStatusSent:      {contracts.PurchaseOrderStatusConfirmed, contracts.PurchaseOrderStatusDraft},
+	contracts.PurchaseOrderStatusConfirmed: {contracts.PurchaseOrderStatusReceived},
+	contracts.PurchaseOrderStatusReceived:  {},
+}
+
+// CreatePurchaseOrders packs the flowers of a confirmed event and turns the packages into one draft
+// purchase order per supplier. Earlier draft orders of the event are replaced, the flowers on orders
+// already sent to the suppliers are not ordered again.
+func (s *ServiceCore) CreatePurchaseOrders(req *contracts.CreatePurchaseOrdersRequest) ([]*persistency.PurchaseOrder, error) {
+	if err := s.authorize(PermissionManagePurchasing); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEventPurchasable(req.EventID); err != nil {
+		return nil, err
+	}
+
+	ordered, err := s.orderedFlowers(req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	flowers, err := s.packFlowersInEvent(&contracts.GetFlowersInEventRequest{
+		EventID:         req.EventID,
+		Strategy:        req.Strategy,
+		MaxWastePercent: req.MaxWastePercent,
+		Sourcing:        req.Sourcing,
+	}, ordered)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseOrders := buildPurchaseOrders(flowers)
+	if err := s.DalInstance.CreatePurchaseOrders(req.EventID, purchaseOrders); err != nil {
+		return nil, err
+	}
+
+	return purchaseOrders, nil
+}
+
+func (s *ServiceCore) GetPurchaseOrder(id string) (*persistency.PurchaseOrder, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetPurchaseOrder(id)
+}
+
+func (s *ServiceCore) GetFilteredPurchaseOrders(req *contracts.GetFilteredPurchaseOrdersRequest) ([]*persistency.PurchaseOrder, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetFilteredPurchaseOrders(req)
+}
+
+func (s *ServiceCore) TransitionPurchaseOrder(req *contracts.TransitionPurchaseOrderRequest) error {
+	if err := s.authorize(PermissionManagePurchasing); err != nil {
+		return err
+	}
+
+	purchaseOrder, err := s.DalInstance.GetPurchaseOrder(req.ID)
+	if err != nil {
+		return err
+	}
+	if purchaseOrder == nil {
+		return persistency.NotFound("purchase order with ID %s does not exist", req.ID)
+	}
+
+	if !canTransitionPurchaseOrder(purchaseOrder.Status, req.Status) {
+		return persistency.Conflict("purchase order with ID %s cannot move from %s to %s", req.ID, purchaseOrder.Status, req.Status)
+	}
+
+	return s.DalInstance.UpdatePurchaseOrderStatus(req.ID, req.Status)
+}
+
+// orderedFlowers counts the flowers of each flower on the purchase orders of the event that left the draft status
+func (s *ServiceCore) orderedFlowers(eventID string) (map[string]int, error) {
+	purchaseOrders, err := s.DalInstance.GetFilteredPurchaseOrders(&contracts.GetFilteredPurchaseOrdersRequest{EventID: eventID})
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := make(map[string]int)
+	for _, purchaseOrder := range purchaseOrders {
+		if purchaseOrder.Status == contracts.PurchaseOrderStatusDraft {
+			continue
+		}
+		for _, line := range purchaseOrder.Lines {
+			ordered[line.FlowerID] += line.NumOfFlowersInPackage * line.NumOfPackages
+		}
+	}
+
+	return ordered, nil
+}
+
+func canTransitionPurchaseOrder(from, to contracts.PurchaseOrderStatus) bool {
+	for _, allowed := range purchaseOrderTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// buildPurchaseOrders groups the packages of an event by supplier, keeping the order of the response.
+func buildPurchaseOrders(flowers *contracts.FlowersPackagesResponse) []*persistency.PurchaseOrder {
+	var purchaseOrders []*persistency.PurchaseOrder
+	bySupplier := make(map[string]*persistency.PurchaseOrder)
+
+	for _, flowerPackages := range flowers.Packages {
+		purchaseOrder, ok := bySupplier[flowerPackages.SupplierID]
+		if !ok {
+			purchaseOrder = &persistency.PurchaseOrder{
+				SupplierID: flowerPackages.SupplierID,
+				Status:     contracts.PurchaseOrderStatusDraft,
+				Lines:      []*persistency.PurchaseOrderLine{},
+			}
+			bySupplier[flowerPackages.SupplierID] = purchaseOrder
+			purchaseOrders = append(purchaseOrders, purchaseOrder)
+		}
+
+		purchaseOrder.Lines = append(purchaseOrder.Lines, &persistency.PurchaseOrderLine{
+			FlowerID:              flowerPackages.FlowerID,
+			FlowerName:            flowerPackages.FlowerName,
+			NumOfFlowersInPackage: flowerPackages.NumOfFlowersInPackage,
+			NumOfPackages:         flowerPackages.NumOfPackages,
+			Price:                 flowerPackages.Price,
+		})
+		purchaseOrder.TotalPrice += flowerPackages.Price * float64(flowerPackages.NumOfPackages)
+		purchaseOrder.TotalPackages += flowerPackages.NumOfPackages
+		purchaseOrder.TotalFlowers += flowerPackages.NumOfFlowersInPackage * flowerPackages.NumOfPackages
+	}
+
+	return purchaseOrders
+}
@@ -0,0 +1,120 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestBuildPurchaseOrdersSplitsBySupplier(t *testing.T) {
+	purchaseOrders := buildPurchaseOrders(&contracts.FlowersPackagesResponse{Packages: []*contracts.FlowerPackages{
+		{FlowerID: "rose", SupplierID: "a", NumOfFlowersInPackage: 10, NumOfPackages: 2, Price: 20},
+		{FlowerID: "rose", SupplierID: "b", NumOfFlowersInPackage: 3, NumOfPackages: 1, Price: 5},
+		{FlowerID: "lily", SupplierID: "a", NumOfFlowersInPackage: 5, NumOfPackages: 3, Price: 4},
+	}})
+
+	if len(purchaseOrders) != 2 || purchaseOrders[0].SupplierID != "a" || purchaseOrders[1].SupplierID != "b" {
+		t.Fatalf("expected one order for a then one for b, got %+v", purchaseOrders)
+	}
+	a, b := purchaseOrders[0], purchaseOrders[1]
+	if len(a.Lines) != 2 || a.TotalPrice != 52 || a.TotalPackages != 5 || a.TotalFlowers != 35 {
+		t.Errorf("unexpected order of supplier a %+v", a)
+	}
+	if len(b.Lines) != 1 || b.TotalPrice != 5 || b.TotalPackages != 1 || b.TotalFlowers != 3 {
+		t.Errorf("unexpected order of supplier b %+v", b)
+	}
+	for _, purchaseOrder := range purchaseOrders {
+		if purchaseOrder.Status != contracts.PurchaseOrderStatusDraft {
+			t.Errorf("new orders should be drafts, got %s", purchaseOrder.Status)
+		}
+	}
+}
+
+// newConfirmedEvent creates a confirmed event needing 36 roses, sold by the default supplier in packages of 10
+func newConfirmedEvent(t *testing.T, dal persistency.DalInterface) string {
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{{Quantity: 10, Price: 5}})
+	dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bouquet"})
+	dal.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: "bouquet", Flowers: &[]contracts.FlowerInProduct{
+		{FlowerID: "rose", NumOfFlowers: 12},
+	}})
+	if err := dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Status: contracts.EventStatusConfirmed}); err != nil {
+		t.Fatal(err)
+	}
+	dal.AddProductsToEvent(&contracts.AddProductsToEventRequest{EventID: "wedding", Products: &[]contracts.ProductInEvent{
+		{ProductID: "bouquet", Quantity: 3},
+	}})
+	return "wedding"
+}
+
+func TestTransitionPurchaseOrder(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	eventID := newConfirmedEvent(t, dal)
+
+	purchaseOrders, err := service.CreatePurchaseOrders(&contracts.CreatePurchaseOrdersRequest{EventID: eventID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(purchaseOrders) != 1 {
+		t.Fatalf("expected a single order, got %d", len(purchaseOrders))
+	}
+	id := purchaseOrders[0].ID
+
+	transition := func(status contracts.PurchaseOrderStatus) error {
+		return service.TransitionPurchaseOrder(&contracts.TransitionPurchaseOrderRequest{ID: id, Status: status})
+	}
+	if err := transition(contracts.PurchaseOrderStatusReceived); !errors.Is(err, persistency.ErrConflict) {
+		t.Errorf("a draft should not be received, got %v", err)
+	}
+	for _, status := range []contracts.PurchaseOrderStatus{
+		contracts.PurchaseOrderStatusSent, contracts.PurchaseOrderStatusConfirmed, contracts.PurchaseOrderStatusReceived,
+	} {
+		if err := transition(status); err != nil {
+			t.Fatalf("moving to %s: %v", status, err)
+		}
+	}
+	if err := transition(contracts.PurchaseOrderStatusDraft); !errors.Is(err, persistency.ErrConflict) {
+		t.Errorf("a received order should not move back, got %v", err)
+	}
+
+	err = service.TransitionPurchaseOrder(&contracts.TransitionPurchaseOrderRequest{
+		ID: "0b8e6a02-5b1c-4f4b-9a51-8d3f0e7c2a11", Status: contracts.PurchaseOrderStatusSent,
+	})
+	if !errors.Is(err, persistency.ErrNotFound) {
+		t.Errorf("a missing order should not be found, got %v", err)
+	}
+}
+
+func TestCreatePurchaseOrdersSkipsOrderedFlowers(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	eventID := newConfirmedEvent(t, dal)
+
+	// a first order of 20 roses already went out to the supplier
+	dal.CreatePurchaseOrders(eventID, []*persistency.PurchaseOrder{{
+		SupplierID: persistency.DefaultSupplierID,
+		Status:     contracts.PurchaseOrderStatusSent,
+		Lines:      []*persistency.PurchaseOrderLine{{FlowerID: "rose", NumOfFlowersInPackage: 10, NumOfPackages: 2}},
+	}})
+
+	purchaseOrders, err := service.CreatePurchaseOrders(&contracts.CreatePurchaseOrdersRequest{EventID: eventID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(purchaseOrders) != 1 || purchaseOrders[0].TotalFlowers != 20 {
+		t.Fatalf("the 16 roses left should take 2 packages of 10, got %+v", purchaseOrders)
+	}
+
+	// orders covering the whole event leave nothing to order
+	dal.UpdatePurchaseOrderStatus(purchaseOrders[0].ID, contracts.PurchaseOrderStatusSent)
+	purchaseOrders, err = service.CreatePurchaseOrders(&contracts.CreatePurchaseOrdersRequest{EventID: eventID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(purchaseOrders) != 0 {
+		t.Errorf("an event fully ordered should need no order, got %+v", purchaseOrders)
+	}
+}
@@ -0,0 +1,104 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	"math"
+)
+
+// GetEventQuote prices every product of an event. The flower cost of a stem is its share of the packing
+// of the whole event, overbought stems included, so the quote does not depend on what is in stock.
+func (s *ServiceCore) GetEventQuote(req *contracts.GetEventQuoteRequest) (*contracts.EventQuoteResponse, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	event, err := s.DalInstance.GetEvent(req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	flowers, err := s.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{
+		EventID:         req.EventID,
+		Strategy:        req.Strategy,
+		MaxWastePercent: req.MaxWastePercent,
+		Sourcing:        req.Sourcing,
+		IgnoreStock:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stemCosts := getStemCosts(flowers)
+
+	products, err := s.DalInstance.GetProductsFromEvent(req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &contracts.EventQuoteResponse{
+		EventID:       event.ID,
+		EventName:     event.Name,
+		EventDate:     event.Date,
+		Strategy:      flowers.Strategy,
+		MarkupPercent: s.QuoteConfig.MarkupPercent,
+		TaxPercent:    s.QuoteConfig.TaxPercent,
+		LineItems:     []*contracts.QuoteLineItem{},
+	}
+	for _, productInEvent := range products {
+		product, err := s.DalInstance.GetProduct(productInEvent.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		flowersInProduct, err := s.DalInstance.GetFlowersFromProduct(productInEvent.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		flowerCost := 0.0
+		for _, flower := range flowersInProduct {
+			flowerCost += stemCosts[flower.FlowerID] * float64(flower.NumOfFlowers)
+		}
+
+		lineItem := &contracts.QuoteLineItem{
+			ProductID:         product.ID,
+			ProductName:       product.Name,
+			Quantity:          productInEvent.Quantity,
+			FlowerCostPerUnit: roundPrice(flowerCost),
+			LaborCostPerUnit:  roundPrice(s.QuoteConfig.LaborCostPerProduct),
+		}
+		lineItem.UnitPrice = roundPrice((lineItem.FlowerCostPerUnit + lineItem.LaborCostPerUnit) * (1 + s.QuoteConfig.MarkupPercent/100))
+		lineItem.LineTotal = roundPrice(lineItem.UnitPrice * float64(lineItem.Quantity))
+
+		quote.LineItems = append(quote.LineItems, lineItem)
+		quote.Subtotal += lineItem.LineTotal
+	}
+
+	quote.Subtotal = roundPrice(quote.Subtotal)
+	quote.Tax = roundPrice(quote.Subtotal * s.QuoteConfig.TaxPercent / 100)
+	quote.GrandTotal = roundPrice(quote.Subtotal + quote.Tax)
+
+	return quote, nil
+}
+
+// getStemCosts spreads the price of the packages of every flower over the stems the event requires.
+func getStemCosts(flowers *contracts.FlowersPackagesResponse) map[string]float64 {
+	prices := make(map[string]float64)
+	required := make(map[string]int)
+	for _, flowerPackages := range flowers.Packages {
+		prices[flowerPackages.FlowerID] += flowerPackages.Price * float64(flowerPackages.NumOfPackages)
+		required[flowerPackages.FlowerID] = flowerPackages.NumOfFlowersRequired
+	}
+
+	stemCosts := make(map[string]float64, len(prices))
+	for flowerID, price := range prices {
+		if required[flowerID] > 0 {
+			stemCosts[flowerID] = price / float64(required[flowerID])
+		}
+	}
+
+	return stemCosts
+}
+
+// roundPrice rounds a price to whole cents
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
@@ -0,0 +1,77 @@
+package servicecore
+
+import (
+	"math"
+	"testing"
+
+	"flower-management/contracts"
+	"flower-management/internal/core/config"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestGetEventQuote(t *testing.T) {
+	// three bouquets of 12 roses take 3 packages of 12 for 18, so a bouquet holds 6 of flowers
+	tests := []struct {
+		name           string
+		quoteConfig    config.QuoteConfig
+		stock          int
+		wantUnitPrice  float64
+		wantSubtotal   float64
+		wantTax        float64
+		wantGrandTotal float64
+	}{
+		{name: "flowers only", wantUnitPrice: 6, wantSubtotal: 18, wantGrandTotal: 18},
+		{name: "labor", quoteConfig: config.QuoteConfig{LaborCostPerProduct: 4}, wantUnitPrice: 10, wantSubtotal: 30, wantGrandTotal: 30},
+		{name: "markup on flowers and labor", quoteConfig: config.QuoteConfig{LaborCostPerProduct: 4, MarkupPercent: 25}, wantUnitPrice: 12.5, wantSubtotal: 37.5, wantGrandTotal: 37.5},
+		{name: "tax on the subtotal", quoteConfig: config.QuoteConfig{TaxPercent: 17}, wantUnitPrice: 6, wantSubtotal: 18, wantTax: 3.06, wantGrandTotal: 21.06},
+		{name: "stock is ignored", stock: 30, wantUnitPrice: 6, wantSubtotal: 18, wantGrandTotal: 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dal := mock.NewDalMock()
+			service := newTestServiceCore(dal)
+			service.QuoteConfig = &tt.quoteConfig
+
+			dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{{Quantity: 12, Price: 6}})
+			dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bouquet"})
+			dal.AddFlowersToProduct(&contracts.AddFlowersToProductRequest{ProductID: "bouquet", Flowers: &[]contracts.FlowerInProduct{
+				{FlowerID: "rose", NumOfFlowers: 12},
+			}})
+			dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Status: contracts.EventStatusInquiry})
+			dal.AddProductsToEvent(&contracts.AddProductsToEventRequest{EventID: "wedding", Products: &[]contracts.ProductInEvent{
+				{ProductID: "bouquet", Quantity: 3},
+			}})
+			if tt.stock > 0 {
+				receipt := contracts.AddStockEntryRequest{FlowerID: "rose", EntryType: contracts.StockEntryTypeReceipt, Quantity: tt.stock}
+				if _, err := service.AddStockEntry(&receipt); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			quote, err := service.GetEventQuote(&contracts.GetEventQuoteRequest{EventID: "wedding"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(quote.LineItems) != 1 {
+				t.Fatalf("expected a line for the bouquets, got %d", len(quote.LineItems))
+			}
+
+			for _, got := range []struct {
+				field     string
+				got, want float64
+			}{
+				{"unit price", quote.LineItems[0].UnitPrice, tt.wantUnitPrice},
+				{"line total", quote.LineItems[0].LineTotal, tt.wantSubtotal},
+				{"subtotal", quote.Subtotal, tt.wantSubtotal},
+				{"tax", quote.Tax, tt.wantTax},
+				{"grand total", quote.GrandTotal, tt.wantGrandTotal},
+			} {
+				if math.Abs(got.got-got.want) > 1e-9 {
+					t.Errorf("got %s %v, want %v", got.field, got.got, got.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"sort"
+)
+
+// quoteTransitions lists the statuses a quote may move to from each status. Accepted, rejected and
+// expired quotes are final, so a quote the customer accepted never changes.
+var quoteTransitions = map[contracts.QuoteStatus][]contracts.QuoteStatus{
+	contracts.QuoteStatusDraft:    {contracts.QuoteStatusSent, contracts.QuoteStatusExpired},
+	contracts.QuoteStatusSent:     {contracts.QuoteStatusAccepted, contracts.QuoteStatusRejected, contracts.QuoteStatusExpired},
+	contracts.QuoteStatusAccepted: {},
+	contracts.QuoteStatusRejected: {},
+	contracts.QuoteStatusExpired:  {},
+}
+
+// CreateQuoteVersion prices the event as it is now and stores the result as the next draft version
+// of its quote. Earlier versions keep the prices they were created with.
+func (s *ServiceCore) CreateQuoteVersion(req *contracts.CreateQuoteRequest) (*persistency.Quote, error) {
+	if err := s.authorize(PermissionManageQuotes); err != nil {
+		return nil, err
+	}
+
+	eventQuote, err := s.GetEventQuote(&contracts.GetEventQuoteRequest{
+		EventID:         req.EventID,
+		Strategy:        req.Strategy,
+		MaxWastePercent: req.MaxWastePercent,
+		Sourcing:        req.Sourcing,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &persistency.Quote{
+		EventID:       eventQuote.EventID,
+		Status:        contracts.QuoteStatusDraft,
+		Strategy:      eventQuote.Strategy,
+		EventName:     eventQuote.EventName,
+		EventDate:     eventQuote.EventDate,
+		MarkupPercent: eventQuote.MarkupPercent,
+		TaxPercent:    eventQuote.TaxPercent,
+		Subtotal:      eventQuote.Subtotal,
+		Tax:           eventQuote.Tax,
+		GrandTotal:    eventQuote.GrandTotal,
+		LineItems:     []*persistency.QuoteLineItem{},
+	}
+	for _, lineItem := range eventQuote.LineItems {
+		quote.LineItems = append(quote.LineItems, &persistency.QuoteLineItem{
+			ProductID:         lineItem.ProductID,
+			ProductName:       lineItem.ProductName,
+			Quantity:          lineItem.Quantity,
+			FlowerCostPerUnit: lineItem.FlowerCostPerUnit,
+			LaborCostPerUnit:  lineItem.LaborCostPerUnit,
+			UnitPrice:         lineItem.UnitPrice,
+			LineTotal:         lineItem.LineTotal,
+		})
+	}
+
+	if err := s.DalInstance.CreateQuote(quote); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+func (s *ServiceCore) GetQuote(id string) (*persistency.Quote, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetQuote(id)
+}
+
+func (s *ServiceCore) GetEventQuotes(eventID string) ([]*persistency.Quote, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.DalInstance.GetEvent(eventID); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetEventQuotes(eventID)
+}
+
+// TransitionQuote moves a quote along its workflow. An event has at most one accepted quote.
+func (s *ServiceCore) TransitionQuote(req *contracts.TransitionQuoteRequest) error {
+	if err := s.authorize(PermissionManageQuotes); err != nil {
+		return err
+	}
+
+	quote, err := s.DalInstance.GetQuote(req.ID)
+	if err != nil {
+		return err
+	}
+	if quote == nil {
+		return persistency.NotFound("quote with ID %s does not exist", req.ID)
+	}
+
+	if !canTransitionQuote(quote.Status, req.Status) {
+		return persistency.Conflict("quote with ID %s cannot move from %s to %s", req.ID, quote.Status, req.Status)
+	}
+
+	if req.Status == contracts.QuoteStatusAccepted {
+		quotes, err := s.DalInstance.GetEventQuotes(quote.EventID)
+		if err != nil {
+			return err
+		}
+		for _, q := range quotes {
+			if q.Status == contracts.QuoteStatusAccepted {
+				return persistency.Conflict("version %d of the quote of event %s is already accepted", q.Version, quote.EventID)
+			}
+		}
+	}
+
+	return s.DalInstance.UpdateQuoteStatus(req.ID, req.Status)
+}
+
+// DiffQuotes compares two versions of the quote of an event product by product.
+func (s *ServiceCore) DiffQuotes(req *contracts.DiffQuotesRequest) (*contracts.QuoteDiffResponse, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	from, err := s.getQuoteVersion(req.EventID, req.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getQuoteVersion(req.EventID, req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffQuotes(from, to), nil
+}
+
+func (s *ServiceCore) getQuoteVersion(eventID string, version int) (*persistency.Quote, error) {
+	quote, err := s.DalInstance.GetQuoteByVersion(eventID, version)
+	if err != nil {
+		return nil, err
+	}
+	if quote == nil {
+		return nil, persistency.NotFound("quote version %d of event %s does not exist", version, eventID)
+	}
+
+	return quote, nil
+}
+
+func canTransitionQuote(from, to contracts.QuoteStatus) bool {
+	for _, allowed := range quoteTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// diffQuotes lists the products that were added, removed or changed between two quotes,
+// unchanged products are left out.
+func diffQuotes(from, to *persistency.Quote) *contracts.QuoteDiffResponse {
+	diff := &contracts.QuoteDiffResponse{
+		EventID:         to.EventID,
+		FromVersion:     from.Version,
+		ToVersion:       to.Version,
+		LineItems:       []*contracts.QuoteLineItemDiff{},
+		SubtotalDelta:   roundPrice(to.Subtotal - from.Subtotal),
+		TaxDelta:        roundPrice(to.Tax - from.Tax),
+		GrandTotalDelta: roundPrice(to.GrandTotal - from.GrandTotal),
+	}
+
+	fromLineItems := make(map[string]*persistency.QuoteLineItem, len(from.LineItems))
+	for _, lineItem := range from.LineItems {
+		fromLineItems[lineItem.ProductID] = lineItem
+	}
+	toLineItems := make(map[string]*persistency.QuoteLineItem, len(to.LineItems))
+	for _, lineItem := range to.LineItems {
+		toLineItems[lineItem.ProductID] = lineItem
+	}
+
+	for _, toLineItem := range to.LineItems {
+		fromLineItem, ok := fromLineItems[toLineItem.ProductID]
+		if !ok {
+			diff.LineItems = append(diff.LineItems, &contracts.QuoteLineItemDiff{
+				ProductID:   toLineItem.ProductID,
+				ProductName: toLineItem.ProductName,
+				Change:      contracts.QuoteChangeAdded,
+				ToQuantity:  toLineItem.Quantity,
+				ToUnitPrice: toLineItem.UnitPrice,
+				ToLineTotal: toLineItem.LineTotal,
+			})
+			continue
+		}
+
+		if fromLineItem.Quantity == toLineItem.Quantity && fromLineItem.UnitPrice == toLineItem.UnitPrice &&
+			fromLineItem.LineTotal == toLineItem.LineTotal {
+			continue
+		}
+		diff.LineItems = append(diff.LineItems, &contracts.QuoteLineItemDiff{
+			ProductID:     toLineItem.ProductID,
+			ProductName:   toLineItem.ProductName,
+			Change:        contracts.QuoteChangeChanged,
+			FromQuantity:  fromLineItem.Quantity,
+			ToQuantity:    toLineItem.Quantity,
+			FromUnitPrice: fromLineItem.UnitPrice,
+			ToUnitPrice:   toLineItem.UnitPrice,
+			FromLineTotal: fromLineItem.LineTotal,
+			ToLineTotal:   toLineItem.LineTotal,
+		})
+	}
+
+	for _, fromLineItem := range from.LineItems {
+		if _, ok := toLineItems[fromLineItem.ProductID]; ok {
+			continue
+		}
+		diff.LineItems = append(diff.LineItems, &contracts.QuoteLineItemDiff{
+			ProductID:     fromLineItem.ProductID,
+			ProductName:   fromLineItem.ProductName,
+			Change:        contracts.QuoteChangeRemoved,
+			FromQuantity:  fromLineItem.Quantity,
+			FromUnitPrice: fromLineItem.UnitPrice,
+			FromLineTotal: fromLineItem.LineTotal,
+		})
+	}
+
+	sort.SliceStable(diff.LineItems, func(i, j int) bool {
+		return diff.LineItems[i].ProductName < diff.LineItems[j].ProductName
+	})
+
+	return diff
+}
@@ -0,0 +1,70 @@
+package servicecore
+
+import (
+	"testing"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+func TestDiffQuotes(t *testing.T) {
+	from := &persistency.Quote{
+		EventID: "event", Version: 1, Subtotal: 100, Tax: 17, GrandTotal: 117,
+		LineItems: []*persistency.QuoteLineItem{
+			{ProductID: "bouquet", ProductName: "Bouquet", Quantity: 2, UnitPrice: 30, LineTotal: 60},
+			{ProductID: "centerpiece", ProductName: "Centerpiece", Quantity: 1, UnitPrice: 25, LineTotal: 25},
+			{ProductID: "garland", ProductName: "Garland", Quantity: 1, UnitPrice: 15, LineTotal: 15},
+		},
+	}
+	to := &persistency.Quote{
+		EventID: "event", Version: 2, Subtotal: 120, Tax: 20.4, GrandTotal: 140.4,
+		LineItems: []*persistency.QuoteLineItem{
+			{ProductID: "bouquet", ProductName: "Bouquet", Quantity: 3, UnitPrice: 30, LineTotal: 90},
+			{ProductID: "arch", ProductName: "Arch", Quantity: 1, UnitPrice: 15, LineTotal: 15},
+			{ProductID: "garland", ProductName: "Garland", Quantity: 1, UnitPrice: 15, LineTotal: 15},
+		},
+	}
+
+	diff := diffQuotes(from, to)
+
+	if diff.FromVersion != 1 || diff.ToVersion != 2 {
+		t.Fatalf("versions = %d..%d, want 1..2", diff.FromVersion, diff.ToVersion)
+	}
+	if diff.SubtotalDelta != 20 || diff.TaxDelta != 3.4 || diff.GrandTotalDelta != 23.4 {
+		t.Errorf("deltas = %v/%v/%v, want 20/3.4/23.4", diff.SubtotalDelta, diff.TaxDelta, diff.GrandTotalDelta)
+	}
+
+	want := map[string]contracts.QuoteChange{
+		"arch":        contracts.QuoteChangeAdded,
+		"bouquet":     contracts.QuoteChangeChanged,
+		"centerpiece": contracts.QuoteChangeRemoved,
+	}
+	if len(diff.LineItems) != len(want) {
+		t.Fatalf("got %d changed line items, want %d", len(diff.LineItems), len(want))
+	}
+	for _, lineItem := range diff.LineItems {
+		if want[lineItem.ProductID] != lineItem.Change {
+			t.Errorf("product %s change = %q, want %q", lineItem.ProductID, lineItem.Change, want[lineItem.ProductID])
+		}
+	}
+}
+
+func TestCanTransitionQuote(t *testing.T) {
+	tests := []struct {
+		from, to contracts.QuoteStatus
+		want     bool
+	}{
+		{contracts.QuoteStatusDraft, contracts.QuoteStatusSent, true},
+		{contracts.QuoteStatusDraft, contracts.QuoteStatusAccepted, false},
+		{contracts.QuoteStatusSent, contracts.QuoteStatusAccepted, true},
+		{contracts.QuoteStatusSent, contracts.QuoteStatusRejected, true},
+		{contracts.QuoteStatusAccepted, contracts.QuoteStatusDraft, false},
+		{contracts.QuoteStatusAccepted, contracts.QuoteStatusExpired, false},
+	}
+
+	for _, tt := range tests {
+		if got := canTransitionQuote(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransitionQuote(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
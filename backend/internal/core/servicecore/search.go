@@ -0,0 +1,61 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"strings"
+)
+
+// Search results are limited per entity type
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+// Search runs a full text search over flowers, products and events and groups the results by type.
+func (s *ServiceCore) Search(req *contracts.SearchRequest) (*contracts.SearchResponse, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, persistency.Invalid("search query must not be empty")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	hits, err := s.DalInstance.Search(&contracts.SearchRequest{Query: query, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &contracts.SearchResponse{
+		Query:    query,
+		Flowers:  []*contracts.SearchResult{},
+		Products: []*contracts.SearchResult{},
+		Events:   []*contracts.SearchResult{},
+	}
+	for _, hit := range hits {
+		result := &contracts.SearchResult{
+			ID:      hit.ID,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+			Rank:    hit.Rank,
+		}
+		switch hit.EntityType {
+		case contracts.SearchEntityFlower:
+			response.Flowers = append(response.Flowers, result)
+		case contracts.SearchEntityProduct:
+			response.Products = append(response.Products, result)
+		case contracts.SearchEntityEvent:
+			response.Events = append(response.Events, result)
+		}
+	}
+
+	return response, nil
+}
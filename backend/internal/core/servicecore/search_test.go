@@ -0,0 +1,58 @@
+package servicecore
+
+import (
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestSearchGroupsByType(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	deletedAt := time.Now()
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Red rose"}, &[]contracts.PackingOptions{})
+	dal.CreateFlower(&persistency.Flower{ID: "old", Name: "Rose", DeletedAt: &deletedAt}, &[]contracts.PackingOptions{})
+	dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bridal bouquet", Description: "Roses and rose petals"})
+	dal.CreateEvent(&persistency.Event{ID: "gala", Name: "Gala", Address: "Rose street 4"})
+
+	response, err := service.Search(&contracts.SearchRequest{Query: "rose"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Flowers) != 1 || len(response.Products) != 1 || len(response.Events) != 1 {
+		t.Fatalf("got %d flowers, %d products, %d events, want one of each",
+			len(response.Flowers), len(response.Products), len(response.Events))
+	}
+	if response.Events[0].Snippet != "Gala - <mark>Rose</mark> street 4" {
+		t.Errorf("snippet = %q", response.Events[0].Snippet)
+	}
+
+	if _, err := service.Search(&contracts.SearchRequest{Query: "  "}); err == nil {
+		t.Errorf("an empty query should be rejected")
+	}
+}
+
+func TestSearchMatchesWholeWords(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	dal.CreateFlower(&persistency.Flower{ID: "roses", Name: "Roses"}, &[]contracts.PackingOptions{})
+	dal.CreateFlower(&persistency.Flower{ID: "primrose", Name: "Primrose"}, &[]contracts.PackingOptions{})
+	dal.CreateProduct(&persistency.Product{ID: "bouquet", Name: "Bouquet", Description: "<b>rose</b> & fern"})
+
+	response, err := service.Search(&contracts.SearchRequest{Query: "rose"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Flowers) != 0 {
+		t.Errorf("rose should not match roses or primrose, got %+v", response.Flowers)
+	}
+	if len(response.Products) != 1 {
+		t.Fatalf("rose should match the bouquet, got %+v", response.Products)
+	}
+	if want := "Bouquet - &lt;b&gt;<mark>rose</mark>&lt;/b&gt; &amp; fern"; response.Products[0].Snippet != want {
+		t.Errorf("snippet = %q, want %q", response.Products[0].Snippet, want)
+	}
+}
@@ -2,23 +2,81 @@ package servicecore
 
 import (
 	"flower-management/contracts"
+	"flower-management/internal/core/config"
 	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
 	"sort"
 )
 
 type ServiceCore struct {
-	DalInstance persistency.DalInterface
+	DalInstance   persistency.DalInterface
+	PackingConfig *config.PackingConfig
+	QuoteConfig   *config.QuoteConfig
+	AuthConfig    *config.AuthConfig
+
+	// user is the authenticated user the service acts for, see WithUser
+	user *persistency.User
+	// system is set for the commands run from the command line, see AsSystem
+	system bool
 }
 
-func NewServiceCore(dalInstance persistency.DalInterface) *ServiceCore {
+func NewServiceCore(dalInstance persistency.DalInterface, packingConfig *config.PackingConfig, quoteConfig *config.QuoteConfig, authConfig *config.AuthConfig) *ServiceCore {
 	return &ServiceCore{
-		DalInstance: dalInstance,
+		DalInstance:   dalInstance,
+		PackingConfig: packingConfig,
+		QuoteConfig:   quoteConfig,
+		AuthConfig:    authConfig,
 	}
 }
 
+// WithActor returns a ServiceCore whose changes are recorded in the audit log as made by actor
+func (s *ServiceCore) WithActor(actor string) *ServiceCore {
+	serviceCore := *s
+	serviceCore.DalInstance = s.DalInstance.WithActor(actor)
+	return &serviceCore
+}
+
+// WithTenant returns a ServiceCore that only sees and changes the rows of the tenant
+func (s *ServiceCore) WithTenant(tenantID string) *ServiceCore {
+	serviceCore := *s
+	serviceCore.DalInstance = s.DalInstance.WithTenant(tenantID)
+	return &serviceCore
+}
+
+func (s *ServiceCore) GetAuditRecords(req *contracts.GetAuditRecordsRequest) ([]*persistency.AuditRecord, error) {
+	if err := s.authorize(PermissionViewAudit); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetAuditRecords(req)
+}
+
 func (s *ServiceCore) CreateFlower(createFlowerRequest *contracts.CreateFlowerRequest) (string, error) {
+	if err := s.authorize(PermissionManageCatalog); err != nil {
+		return "", err
+	}
+	// packing options carry the prices of the flower
+	if createFlowerRequest.PackingOptions != nil && len(*createFlowerRequest.PackingOptions) > 0 {
+		if err := s.authorize(PermissionManagePrices); err != nil {
+			return "", err
+		}
+	}
+
+	// check if the suppliers exist, options without a supplier go to the default one
+	if createFlowerRequest.PackingOptions != nil {
+		for _, packingOption := range *createFlowerRequest.PackingOptions {
+			if packingOption.SupplierID == "" {
+				continue
+			}
+			if _, err := s.DalInstance.GetSupplier(packingOption.SupplierID); err != nil {
+				return "", err
+			}
+		}
+	}
+
 	flower := &persistency.Flower{
-		Name: createFlowerRequest.Name,
+		Name:          createFlowerRequest.Name,
+		ShelfLifeDays: createFlowerRequest.ShelfLifeDays,
 	}
 	err := s.DalInstance.CreateFlower(flower, createFlowerRequest.PackingOptions)
 
@@ -26,6 +84,10 @@ func (s *ServiceCore) CreateFlower(createFlowerRequest *contracts.CreateFlowerRe
 }
 
 func (s *ServiceCore) CreateProduct(createProductRequest *contracts.CreateProductRequest) (string, error) {
+	if err := s.authorize(PermissionManageCatalog); err != nil {
+		return "", err
+	}
+
 	product := &persistency.Product{
 		Name:        createProductRequest.Name,
 		Description: createProductRequest.Description,
@@ -36,29 +98,63 @@ func (s *ServiceCore) CreateProduct(createProductRequest *contracts.CreateProduc
 }
 
 func (s *ServiceCore) CreateEvent(createEventRequest *contracts.CreateEventRequest) (string, error) {
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return "", err
+	}
+
 	event := &persistency.Event{
 		Name:        createEventRequest.Name,
 		Date:        createEventRequest.Date,
+		Status:      contracts.EventStatusInquiry,
+		CustomerID:  createEventRequest.CustomerID,
 		Phone:       createEventRequest.Phone,
 		Email:       createEventRequest.Email,
 		Address:     createEventRequest.Address,
 		Description: createEventRequest.Description,
 	}
+	if err := s.fillEventContactFromCustomer(event); err != nil {
+		return "", err
+	}
 	err := s.DalInstance.CreateEvent(event)
 
 	return event.ID, err
 }
 
+func (s *ServiceCore) CreateSupplier(createSupplierRequest *contracts.CreateSupplierRequest) (string, error) {
+	if err := s.authorize(PermissionManagePurchasing); err != nil {
+		return "", err
+	}
+
+	supplier := &persistency.Supplier{
+		Name:    createSupplierRequest.Name,
+		Phone:   createSupplierRequest.Phone,
+		Email:   createSupplierRequest.Email,
+		Address: createSupplierRequest.Address,
+	}
+	err := s.DalInstance.CreateSupplier(supplier)
+
+	return supplier.ID, err
+}
+
 func (s *ServiceCore) EditFlower(editFlowerRequest *contracts.EditFlowerRequest) error {
+	if err := s.authorize(PermissionManageCatalog); err != nil {
+		return err
+	}
+
 	flower := &persistency.Flower{
-		ID:   editFlowerRequest.ID,
-		Name: editFlowerRequest.Name,
+		ID:            editFlowerRequest.ID,
+		Name:          editFlowerRequest.Name,
+		ShelfLifeDays: editFlowerRequest.ShelfLifeDays,
 	}
 
 	return s.DalInstance.EditFlower(flower)
 }
 
 func (s *ServiceCore) EditProduct(editProductRequest *contracts.EditProductRequest) error {
+	if err := s.authorize(PermissionManageCatalog); err != nil {
+		return err
+	}
+
 	product := &persistency.Product{
 		ID:          editProductRequest.ID,
 		Name:        editProductRequest.Name,
@@ -70,65 +166,220 @@ func (s *ServiceCore) EditProduct(editProductRequest *contracts.EditProductReque
 }
 
 func (s *ServiceCore) EditEvent(editEventRequest *contracts.EditEventRequest) error {
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return err
+	}
+
 	event := &persistency.Event{
 		ID:          editEventRequest.ID,
 		Name:        editEventRequest.Name,
 		Date:        editEventRequest.Date,
+		CustomerID:  editEventRequest.CustomerID,
 		Phone:       editEventRequest.Phone,
 		Email:       editEventRequest.Email,
 		Address:     editEventRequest.Address,
 		Description: editEventRequest.Description,
 	}
+	if err := s.fillEventContactFromCustomer(event); err != nil {
+		return err
+	}
 
 	return s.DalInstance.EditEvent(event)
 }
 
+func (s *ServiceCore) EditSupplier(editSupplierRequest *contracts.EditSupplierRequest) error {
+	if err := s.authorize(PermissionManagePurchasing); err != nil {
+		return err
+	}
+
+	supplier := &persistency.Supplier{
+		ID:      editSupplierRequest.ID,
+		Name:    editSupplierRequest.Name,
+		Phone:   editSupplierRequest.Phone,
+		Email:   editSupplierRequest.Email,
+		Address: editSupplierRequest.Address,
+	}
+
+	return s.DalInstance.EditSupplier(supplier)
+}
+
 func (s *ServiceCore) DeleteFlower(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
 	return s.DalInstance.DeleteFlower(id)
 }
 
 func (s *ServiceCore) DeleteProduct(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
 	return s.DalInstance.DeleteProduct(id)
 }
 
 func (s *ServiceCore) DeleteEvent(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
 	return s.DalInstance.DeleteEvent(id)
 }
 
-func (s *ServiceCore) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, error) {
+func (s *ServiceCore) DeleteSupplier(id string) error {
+	if err := s.authorize(PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.DalInstance.DeleteSupplier(id)
+}
+
+func (s *ServiceCore) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, *persistency.Page, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, nil, err
+	}
+
+	req.Page = withPageLimit(req.Page)
 	return s.DalInstance.GetFilteredFlowers(req)
 }
 
-func (s *ServiceCore) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, error) {
+func (s *ServiceCore) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, *persistency.Page, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, nil, err
+	}
+
+	req.Page = withPageLimit(req.Page)
 	return s.DalInstance.GetFilteredProducts(req)
 }
 
-func (s *ServiceCore) GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*persistency.Event, error) {
+func (s *ServiceCore) GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*persistency.Event, *persistency.Page, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, nil, err
+	}
+
+	req.Page = withPageLimit(req.Page)
 	return s.DalInstance.GetFilteredEvents(req)
 }
 
+func (s *ServiceCore) GetFilteredSuppliers(req *contracts.GetFilteredSuppliersRequest) ([]*persistency.Supplier, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetFilteredSuppliers(req)
+}
+
 func (s *ServiceCore) GetEvent(id string) (*persistency.Event, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
 	return s.DalInstance.GetEvent(id)
 }
 
 func (s *ServiceCore) GetProduct(id string) (*persistency.Product, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
 	return s.DalInstance.GetProduct(id)
 }
 
 func (s *ServiceCore) GetFlower(id string) (*persistency.Flower, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
 	return s.DalInstance.GetFlower(id)
 }
 
+func (s *ServiceCore) GetSupplier(id string) (*persistency.Supplier, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetSupplier(id)
+}
+
+func (s *ServiceCore) GetFlowerPackingOptions(flowerID string) ([]*persistency.FlowerPackageOptions, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	// check if the flower exists
+	if _, err := s.DalInstance.GetFlower(flowerID); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetFlowerPackingOptions(flowerID)
+}
+
+// GetProductsFromEvents returns the products of several events at once, events of another tenant have none
+func (s *ServiceCore) GetProductsFromEvents(eventIDs []string) ([]*persistency.EventProduct, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetProductsFromEvents(eventIDs)
+}
+
+// GetFlowersFromProducts returns the flowers of several products at once, products of another tenant have none
+func (s *ServiceCore) GetFlowersFromProducts(productIDs []string) ([]*persistency.FlowerInProduct, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetFlowersFromProducts(productIDs)
+}
+
+// GetFlowersPackingOptions returns the packing options of several flowers at once, flowers of another tenant have none
+func (s *ServiceCore) GetFlowersPackingOptions(flowerIDs []string) ([]*persistency.FlowerPackageOptions, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetFlowersPackingOptions(flowerIDs)
+}
+
+func (s *ServiceCore) SetFlowerPackingOptions(req *contracts.SetFlowerPackingOptionsRequest) error {
+	if err := s.authorize(PermissionManagePrices); err != nil {
+		return err
+	}
+
+	// check if the flower exists
+	if _, err := s.DalInstance.GetFlower(req.FlowerID); err != nil {
+		return err
+	}
+
+	// check if the supplier exists
+	if _, err := s.DalInstance.GetSupplier(req.SupplierID); err != nil {
+		return err
+	}
+
+	return s.DalInstance.SetFlowerPackingOptions(req)
+}
+
 func (s *ServiceCore) AddFlowersToProduct(req *contracts.AddFlowersToProductRequest) error {
-	// check if the product exists
-	_, err := s.DalInstance.GetProduct(req.ProductID)
+	if err := s.authorize(PermissionManageCatalog); err != nil {
+		return err
+	}
+
+	// check if the product exists and is not deleted
+	_, err := s.getActiveProduct(req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// check no event using the product is in production
+	err = s.checkProductFlowersEditable(req.ProductID)
 	if err != nil {
 		return err
 	}
 
-	// check if the flowers exist
+	// check if the flowers exist and are not deleted
 	for _, flowerInProduct := range *req.Flowers {
-		_, err := s.DalInstance.GetFlower(flowerInProduct.FlowerID)
+		_, err := s.getActiveFlower(flowerInProduct.FlowerID)
 		if err != nil {
 			return err
 		}
@@ -137,15 +388,19 @@ func (s *ServiceCore) AddFlowersToProduct(req *contracts.AddFlowersToProductRequ
 }
 
 func (s *ServiceCore) AddProductsToEvent(req *contracts.AddProductsToEventRequest) error {
-	// check if the event exists
-	_, err := s.DalInstance.GetEvent(req.EventID)
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return err
+	}
+
+	// check if the event exists and its products may still change
+	err := s.checkEventProductsEditable(req.EventID)
 	if err != nil {
 		return err
 	}
 
-	// check if the products exist
+	// check if the products exist and are not deleted
 	for _, productInEvent := range *req.Products {
-		_, err := s.DalInstance.GetProduct(productInEvent.ProductID)
+		_, err := s.getActiveProduct(productInEvent.ProductID)
 		if err != nil {
 			return err
 		}
@@ -154,15 +409,25 @@ func (s *ServiceCore) AddProductsToEvent(req *contracts.AddProductsToEventReques
 }
 
 func (s *ServiceCore) EditFlowersInProduct(req *contracts.AddFlowersToProductRequest) error {
-	// check if the product exists
-	_, err := s.DalInstance.GetProduct(req.ProductID)
+	if err := s.authorize(PermissionManageCatalog); err != nil {
+		return err
+	}
+
+	// check if the product exists and is not deleted
+	_, err := s.getActiveProduct(req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// check no event using the product is in production
+	err = s.checkProductFlowersEditable(req.ProductID)
 	if err != nil {
 		return err
 	}
 
-	// check if the flowers exist
+	// check if the flowers exist and are not deleted
 	for _, flowerInProduct := range *req.Flowers {
-		_, err := s.DalInstance.GetFlower(flowerInProduct.FlowerID)
+		_, err := s.getActiveFlower(flowerInProduct.FlowerID)
 		if err != nil {
 			return err
 		}
@@ -171,15 +436,19 @@ func (s *ServiceCore) EditFlowersInProduct(req *contracts.AddFlowersToProductReq
 }
 
 func (s *ServiceCore) EditProductsInEvent(req *contracts.AddProductsToEventRequest) error {
-	// check if the event exists
-	_, err := s.DalInstance.GetEvent(req.EventID)
+	if err := s.authorize(PermissionManageEvents); err != nil {
+		return err
+	}
+
+	// check if the event exists and its products may still change
+	err := s.checkEventProductsEditable(req.EventID)
 	if err != nil {
 		return err
 	}
 
-	// check if the products exist
+	// check if the products exist and are not deleted
 	for _, productInEvent := range *req.Products {
-		_, err := s.DalInstance.GetProduct(productInEvent.ProductID)
+		_, err := s.getActiveProduct(productInEvent.ProductID)
 		if err != nil {
 			return err
 		}
@@ -187,13 +456,33 @@ func (s *ServiceCore) EditProductsInEvent(req *contracts.AddProductsToEventReque
 	return s.DalInstance.EditProductsInEvent(req)
 }
 
-func (s *ServiceCore) GetFlowersInEvent(eventID string) ([]*contracts.FlowersPackagesResponse, error) {
+func (s *ServiceCore) GetFlowersInEvent(req *contracts.GetFlowersInEventRequest) (*contracts.FlowersPackagesResponse, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.packFlowersInEvent(req, nil)
+}
+
+// packFlowersInEvent packs the flowers the event needs beyond its stock and the flowers already ordered,
+// ordered maps a flower to the number of its flowers on purchase orders of the event
+func (s *ServiceCore) packFlowersInEvent(req *contracts.GetFlowersInEventRequest, ordered map[string]int) (*contracts.FlowersPackagesResponse, error) {
 	// check if the event exists
-	if _, err := s.DalInstance.GetEvent(eventID); err != nil {
+	event, err := s.DalInstance.GetEvent(req.EventID)
+	if err != nil {
 		return nil, err
 	}
 
-	products, err := s.DalInstance.GetProductsFromEvent(eventID)
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = contracts.PackingStrategyCheapest
+	}
+	sourcing := req.Sourcing
+	if sourcing == "" {
+		sourcing = contracts.SourcingModeCheapestSupplier
+	}
+
+	products, err := s.DalInstance.GetProductsFromEvent(req.EventID)
 	if err != nil {
 		return nil, err
 	}
@@ -205,91 +494,108 @@ func (s *ServiceCore) GetFlowersInEvent(eventID string) ([]*contracts.FlowersPac
 			return nil, err
 		}
 		for _, flower := range flowers {
-			flowersInEvent[flower.FlowerID] += flower.NumOfFlowers
+			flowersInEvent[flower.FlowerID] += flower.NumOfFlowers * product.Quantity
 		}
 	}
 
-	var response []*contracts.FlowersPackagesResponse
-	for flowerID, numOfFlowers := range flowersInEvent {
-		flower, err := s.DalInstance.GetFlower(flowerID)
+	// iterate the flowers in a stable order so the response is deterministic
+	flowerIDs := make([]string, 0, len(flowersInEvent))
+	for flowerID := range flowersInEvent {
+		flowerIDs = append(flowerIDs, flowerID)
+	}
+	sort.Strings(flowerIDs)
+
+	// flowers already in stock are not bought again
+	stock := make(map[string]int)
+	if !req.IgnoreStock {
+		stock, err = s.getStockForEvent(event, flowerIDs)
 		if err != nil {
 			return nil, err
 		}
-		packingOptions, err := s.DalInstance.GetFlowerPackingOptions(flowerID)
+	}
+
+	response := &contracts.FlowersPackagesResponse{
+		Strategy:        strategy,
+		MaxWastePercent: req.MaxWastePercent,
+		Sourcing:        sourcing,
+		Packages:        []*contracts.FlowerPackages{},
+		FromStock:       []*contracts.FlowerFromStock{},
+	}
+	suppliers := make(map[string]*persistency.Supplier)
+	for _, flowerID := range flowerIDs {
+		numOfFlowers := flowersInEvent[flowerID]
+		fromStock := min(stock[flowerID], numOfFlowers)
+		response.TotalFlowersRequired += numOfFlowers
+		response.TotalFlowersFromStock += fromStock
+		flower, err := s.DalInstance.GetFlower(flowerID)
 		if err != nil {
 			return nil, err
 		}
 
-		// sort the packing options by count of flowers
-		sort.Slice(packingOptions, func(i, j int) bool {
-			return packingOptions[i].NumOfFlowers > packingOptions[j].NumOfFlowers
-		})
+		toBuy := numOfFlowers - fromStock - ordered[flowerID]
+		if toBuy <= 0 {
+			if fromStock > 0 {
+				response.FromStock = append(response.FromStock, &contracts.FlowerFromStock{
+					FlowerID:              flowerID,
+					FlowerName:            flower.Name,
+					NumOfFlowersRequired:  numOfFlowers,
+					NumOfFlowersFromStock: fromStock,
+				})
+			}
+			continue
+		}
 
-		res := calcBestOption(numOfFlowers, packingOptions)
-		for numOfFlowersInPackage, numOfPackages := range res {
-			response = append(response, &contracts.FlowersPackagesResponse{
-				FlowerID:              flowerID,
-				FlowerName:            flower.Name,
-				NumOfFlowersInPackage: numOfFlowersInPackage,
-				NumOfPackages:         numOfPackages,
-				Price:                 getPriceFromNumOfFlowers(numOfFlowersInPackage, packingOptions),
-			})
+		packingOptions, err := s.DalInstance.GetFlowerPackingOptions(flowerID)
+		if err != nil {
+			return nil, err
 		}
 
-	}
+		overbuyLimit := overbuyLimitForStrategy(toBuy, s.PackingConfig.OverbuyLimit, strategy, req.MaxWastePercent)
+		res, err := packFlower(toBuy, overbuyLimit, strategy, sourcing, packingOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack flower %s: %w", flowerID, err)
+		}
 
-	return response, nil
-}
+		response.TotalPrice += res.totalPrice
+		response.TotalPackages += res.totalPackages
+		response.TotalFlowersOverbought += res.overbought
 
-func calcBestOption(numOfFlowers int, packingOptions []*persistency.FlowerPackageOptions) map[int]int {
-	for {
-		results := make(map[int]int)
-		if numOfFlowers == 0 {
-			break
+		// list the packages by supplier, from the biggest to the smallest
+		chosenOptions := make([]*persistency.FlowerPackageOptions, 0, len(res.packages))
+		for option := range res.packages {
+			chosenOptions = append(chosenOptions, option)
 		}
-		for i := 0; i < len(packingOptions); i++ {
-			numOfPackages := numOfFlowers / packingOptions[i].NumOfFlowers
-			remindFlowers := numOfFlowers % packingOptions[i].NumOfFlowers
-			if numOfPackages != 0 {
-				results[packingOptions[i].NumOfFlowers] = numOfPackages
+		sort.Slice(chosenOptions, func(i, j int) bool {
+			if chosenOptions[i].SupplierID != chosenOptions[j].SupplierID {
+				return chosenOptions[i].SupplierID < chosenOptions[j].SupplierID
 			}
-			if remindFlowers != 0 && i < len(packingOptions)-1 {
-				optinalResults := calcBestOption(remindFlowers, packingOptions[i+1:])
-				for numOfFlowersInPackage, numOfPackagesRes := range results {
-					optinalResults[numOfFlowersInPackage] += numOfPackagesRes
-				}
-				var optinalPrice float64
-				for numOfFlowersInPackage, numOfPackagesRes := range optinalResults {
-					optinalPrice += getPriceFromNumOfFlowers(numOfFlowersInPackage, packingOptions) * float64(numOfPackagesRes)
-				}
-				results[packingOptions[i].NumOfFlowers]++
-				var price float64
-				for numOfFlowersInPackage, numOfPackagesRes := range results {
-					price += getPriceFromNumOfFlowers(numOfFlowersInPackage, packingOptions) * float64(numOfPackagesRes)
-				}
-				if price < optinalPrice {
-					return results
-				} else {
-					return optinalResults
-				}
+			return chosenOptions[i].NumOfFlowers > chosenOptions[j].NumOfFlowers
+		})
 
-			} else if remindFlowers == 0 {
-				results[packingOptions[i].NumOfFlowers] = numOfPackages
-				return results
-			} else {
-				results[packingOptions[i].NumOfFlowers] = numOfPackages + 1
-				return results
+		for _, option := range chosenOptions {
+			supplier, ok := suppliers[option.SupplierID]
+			if !ok {
+				supplier, err = s.DalInstance.GetSupplier(option.SupplierID)
+				if err != nil {
+					return nil, err
+				}
+				suppliers[option.SupplierID] = supplier
 			}
-		}
-	}
-	return nil
-}
 
-func getPriceFromNumOfFlowers(numOfFlowers int, packingOptions []*persistency.FlowerPackageOptions) float64 {
-	for _, option := range packingOptions {
-		if option.NumOfFlowers == numOfFlowers {
-			return option.Price
+			response.Packages = append(response.Packages, &contracts.FlowerPackages{
+				FlowerID:               flowerID,
+				FlowerName:             flower.Name,
+				SupplierID:             option.SupplierID,
+				SupplierName:           supplier.Name,
+				NumOfFlowersInPackage:  option.NumOfFlowers,
+				NumOfPackages:          res.packages[option],
+				Price:                  option.Price,
+				NumOfFlowersRequired:   numOfFlowers,
+				NumOfFlowersFromStock:  fromStock,
+				NumOfFlowersOverbought: res.overbought,
+			})
 		}
 	}
-	return 0
+
+	return response, nil
 }
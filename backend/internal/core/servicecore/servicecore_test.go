@@ -0,0 +1,13 @@
+package servicecore
+
+import (
+	"flower-management/internal/core/config"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// newTestServiceCore returns a ServiceCore over dal with empty configs, auth disabled and packing without
+// overbuy limit. The tests of the API packages use servicecoretest.New instead, which cannot be imported
+// from here.
+func newTestServiceCore(dal persistency.DalInterface) *ServiceCore {
+	return NewServiceCore(dal, &config.PackingConfig{OverbuyLimit: -1}, &config.QuoteConfig{}, &config.AuthConfig{})
+}
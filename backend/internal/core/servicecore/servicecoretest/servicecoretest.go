@@ -0,0 +1,20 @@
+// Package servicecoretest builds the ServiceCore the tests of the API packages run against.
+package servicecoretest
+
+import (
+	"flower-management/internal/core/config"
+	"flower-management/internal/core/servicecore"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// New returns a ServiceCore over dal with empty configs, auth disabled and packing without overbuy limit
+func New(dal persistency.DalInterface) *servicecore.ServiceCore {
+	return servicecore.NewServiceCore(dal, &config.PackingConfig{OverbuyLimit: -1}, &config.QuoteConfig{}, &config.AuthConfig{})
+}
+
+// NewWithAuth returns a ServiceCore like New with the given auth config
+func NewWithAuth(dal persistency.DalInterface, authConfig *config.AuthConfig) *servicecore.ServiceCore {
+	service := New(dal)
+	service.AuthConfig = authConfig
+	return service
+}
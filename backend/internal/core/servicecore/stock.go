@@ -0,0 +1,245 @@
+package servicecore
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+)
+
+// AddStockEntry records a stock movement and returns the ledger entries it created. Receipts open a
+// new lot. Allocations and waste without a lot are taken first-expiring-first-out, allocations only
+// from lots that are still fresh on the day of the event.
+func (s *ServiceCore) AddStockEntry(req *contracts.AddStockEntryRequest) ([]*persistency.StockEntry, error) {
+	if err := s.authorize(PermissionManageStock); err != nil {
+		return nil, err
+	}
+
+	flower, err := s.DalInstance.GetFlower(req.FlowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.EntryType != contracts.StockEntryTypeAdjustment && req.Quantity <= 0 {
+		return nil, persistency.Invalid("the quantity of a %s must be positive", req.EntryType)
+	}
+
+	switch req.EntryType {
+	case contracts.StockEntryTypeReceipt:
+		return s.receiveStockLot(flower, req)
+	case contracts.StockEntryTypeAllocation:
+		if req.EventID == "" {
+			return nil, persistency.Invalid("an allocation must reference an event")
+		}
+		event, err := s.DalInstance.GetEvent(req.EventID)
+		if err != nil {
+			return nil, err
+		}
+		return s.takeStock(req, event.Date)
+	case contracts.StockEntryTypeWaste:
+		return s.takeStock(req, time.Time{})
+	case contracts.StockEntryTypeAdjustment:
+		if req.Quantity == 0 {
+			return nil, persistency.Invalid("an adjustment must change the stock")
+		}
+		if req.LotID == "" {
+			return nil, persistency.Invalid("an adjustment must reference a stock lot")
+		}
+		entries := []*persistency.StockEntry{{
+			FlowerID:  req.FlowerID,
+			LotID:     req.LotID,
+			EntryType: req.EntryType,
+			Quantity:  req.Quantity,
+			EventID:   req.EventID,
+			Note:      req.Note,
+		}}
+		return entries, s.DalInstance.AddStockEntries(entries)
+	default:
+		return nil, persistency.Invalid("unknown stock entry type %s", req.EntryType)
+	}
+}
+
+func (s *ServiceCore) GetStockEntries(req *contracts.GetStockEntriesRequest) ([]*persistency.StockEntry, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetStockEntries(req)
+}
+
+func (s *ServiceCore) GetStockLevels() ([]*persistency.StockLevel, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetStockLevels(nil)
+}
+
+func (s *ServiceCore) GetStockLots(req *contracts.GetStockLotsRequest) ([]*persistency.StockLot, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetStockLots(req)
+}
+
+// GetExpiringStockLots returns the lots that still hold flowers and expire before the given time.
+func (s *ServiceCore) GetExpiringStockLots(before time.Time) ([]*persistency.StockLot, error) {
+	if err := s.authorize(PermissionView); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetStockLots(&contracts.GetStockLotsRequest{
+		OnlyAvailable:  true,
+		ExpiringBefore: before,
+	})
+}
+
+func (s *ServiceCore) receiveStockLot(flower *persistency.Flower, req *contracts.AddStockEntryRequest) ([]*persistency.StockEntry, error) {
+	receivedAt := req.ReceivedAt
+	if receivedAt.IsZero() {
+		receivedAt = time.Now().UTC()
+	}
+
+	lot := &persistency.StockLot{
+		FlowerID:   flower.ID,
+		ReceivedAt: receivedAt,
+		Quantity:   req.Quantity,
+	}
+	if flower.ShelfLifeDays > 0 {
+		expiresAt := receivedAt.AddDate(0, 0, flower.ShelfLifeDays)
+		lot.ExpiresAt = &expiresAt
+	}
+
+	receipt := &persistency.StockEntry{
+		FlowerID:  flower.ID,
+		EntryType: contracts.StockEntryTypeReceipt,
+		Quantity:  req.Quantity,
+		EventID:   req.EventID,
+		Note:      req.Note,
+	}
+	if err := s.DalInstance.CreateStockLot(lot, receipt); err != nil {
+		return nil, err
+	}
+
+	return []*persistency.StockEntry{receipt}, nil
+}
+
+// takeStock removes flowers from the given lot, or from the available lots first-expiring-first-out.
+// When freshAt is set only lots that have not expired by then are used.
+func (s *ServiceCore) takeStock(req *contracts.AddStockEntryRequest, freshAt time.Time) ([]*persistency.StockEntry, error) {
+	var takes map[string]int
+	var lotIDs []string
+	if req.LotID != "" {
+		takes = map[string]int{req.LotID: req.Quantity}
+		lotIDs = []string{req.LotID}
+	} else {
+		lots, err := s.DalInstance.GetStockLots(&contracts.GetStockLotsRequest{
+			FlowerIDs:     []string{req.FlowerID},
+			OnlyAvailable: true,
+			FreshAt:       freshAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		takes, lotIDs, err = planFirstExpiringFirstOut(lots, req.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to take %d of flower %s: %w", req.Quantity, req.FlowerID, err)
+		}
+	}
+
+	entries := make([]*persistency.StockEntry, 0, len(lotIDs))
+	for _, lotID := range lotIDs {
+		entries = append(entries, &persistency.StockEntry{
+			FlowerID:  req.FlowerID,
+			LotID:     lotID,
+			EntryType: req.EntryType,
+			Quantity:  -takes[lotID],
+			EventID:   req.EventID,
+			Note:      req.Note,
+		})
+	}
+	if err := s.DalInstance.AddStockEntries(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// planFirstExpiringFirstOut spreads quantity over lots that are already ordered first-expiring-first.
+// It returns how much to take from each lot and the lots in the order they are used.
+func planFirstExpiringFirstOut(lots []*persistency.StockLot, quantity int) (map[string]int, []string, error) {
+	takes := make(map[string]int)
+	var lotIDs []string
+	left := quantity
+	for _, lot := range lots {
+		if left == 0 {
+			break
+		}
+		if lot.Remaining <= 0 {
+			continue
+		}
+		take := min(lot.Remaining, left)
+		takes[lot.ID] = take
+		lotIDs = append(lotIDs, lot.ID)
+		left -= take
+	}
+	if left > 0 {
+		return nil, nil, persistency.Invalid("only %d flowers available", quantity-left)
+	}
+
+	return takes, lotIDs, nil
+}
+
+// getStockForEvent returns per flower the stems that can cover the needs of an event: the stems already
+// allocated to the event and the free stems of lots still fresh on the day of the event. The remaining
+// stems of a lot leave out the stems allocated to other events, and lots received for another event are
+// kept for it.
+func (s *ServiceCore) getStockForEvent(event *persistency.Event, flowerIDs []string) (map[string]int, error) {
+	stock := make(map[string]int)
+	if len(flowerIDs) == 0 {
+		return stock, nil
+	}
+
+	lots, err := s.DalInstance.GetStockLots(&contracts.GetStockLotsRequest{
+		FlowerIDs:     flowerIDs,
+		OnlyAvailable: true,
+		FreshAt:       event.Date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := s.DalInstance.GetStockEntries(&contracts.GetStockEntriesRequest{
+		EntryType: contracts.StockEntryTypeReceipt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reservedLots := make(map[string]bool)
+	for _, receipt := range receipts {
+		if receipt.EventID != "" && receipt.EventID != event.ID {
+			reservedLots[receipt.LotID] = true
+		}
+	}
+
+	for _, lot := range lots {
+		if reservedLots[lot.ID] {
+			continue
+		}
+		stock[lot.FlowerID] += lot.Remaining
+	}
+
+	allocations, err := s.DalInstance.GetStockEntries(&contracts.GetStockEntriesRequest{
+		EventID:   event.ID,
+		EntryType: contracts.StockEntryTypeAllocation,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, allocation := range allocations {
+		stock[allocation.FlowerID] -= allocation.Quantity
+	}
+
+	return stock, nil
+}
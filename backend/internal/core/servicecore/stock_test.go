@@ -0,0 +1,199 @@
+package servicecore
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestPlanFirstExpiringFirstOut(t *testing.T) {
+	lots := []*persistency.StockLot{
+		{ID: "expires-first", Remaining: 5},
+		{ID: "empty", Remaining: 0},
+		{ID: "expires-later", Remaining: 10},
+		{ID: "never-expires", Remaining: 20},
+	}
+
+	tests := []struct {
+		name       string
+		quantity   int
+		wantTakes  map[string]int
+		wantLotIDs []string
+		wantErr    bool
+	}{
+		{name: "single lot", quantity: 3, wantTakes: map[string]int{"expires-first": 3}, wantLotIDs: []string{"expires-first"}},
+		{name: "drains the first lot exactly", quantity: 5, wantTakes: map[string]int{"expires-first": 5}, wantLotIDs: []string{"expires-first"}},
+		{name: "spills over skipping empty lots", quantity: 12, wantTakes: map[string]int{"expires-first": 5, "expires-later": 7}, wantLotIDs: []string{"expires-first", "expires-later"}},
+		{name: "uses every lot", quantity: 35, wantTakes: map[string]int{"expires-first": 5, "expires-later": 10, "never-expires": 20}, wantLotIDs: []string{"expires-first", "expires-later", "never-expires"}},
+		{name: "not enough stock", quantity: 36, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			takes, lotIDs, err := planFirstExpiringFirstOut(lots, tt.quantity)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", takes)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(takes, tt.wantTakes) {
+				t.Fatalf("got takes %v, want %v", takes, tt.wantTakes)
+			}
+			if !reflect.DeepEqual(lotIDs, tt.wantLotIDs) {
+				t.Fatalf("got lots %v, want %v", lotIDs, tt.wantLotIDs)
+			}
+		})
+	}
+}
+
+func TestStockLedger(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose"}, &[]contracts.PackingOptions{})
+	dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Date: time.Now().AddDate(0, 0, 7)})
+
+	addStockEntry := func(req contracts.AddStockEntryRequest) []*persistency.StockEntry {
+		t.Helper()
+		req.FlowerID = "rose"
+		entries, err := service.AddStockEntry(&req)
+		if err != nil {
+			t.Fatalf("adding a %s: %v", req.EntryType, err)
+		}
+		return entries
+	}
+
+	first := addStockEntry(contracts.AddStockEntryRequest{EntryType: contracts.StockEntryTypeReceipt, Quantity: 10})
+	addStockEntry(contracts.AddStockEntryRequest{EntryType: contracts.StockEntryTypeReceipt, Quantity: 20})
+	allocation := addStockEntry(contracts.AddStockEntryRequest{EntryType: contracts.StockEntryTypeAllocation, Quantity: 15, EventID: "wedding"})
+	if len(allocation) != 2 || allocation[0].LotID != first[0].LotID || allocation[0].Quantity != -10 || allocation[1].Quantity != -5 {
+		t.Errorf("the allocation should drain the first lot then take 5 of the second, got %+v %+v", allocation[0], allocation[1])
+	}
+	addStockEntry(contracts.AddStockEntryRequest{EntryType: contracts.StockEntryTypeWaste, Quantity: 3})
+	addStockEntry(contracts.AddStockEntryRequest{EntryType: contracts.StockEntryTypeAdjustment, Quantity: -2, LotID: allocation[1].LotID})
+
+	levels, err := service.GetStockLevels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != 1 || levels[0].OnHand != 10 {
+		t.Errorf("30 received less 15 allocated, 3 wasted and 2 adjusted should leave 10, got %+v", levels)
+	}
+
+	entries, err := service.GetStockEntries(&contracts.GetStockEntriesRequest{EventID: "wedding"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("the ledger should list the 2 entries of the event, got %d", len(entries))
+	}
+
+	invalid := []contracts.AddStockEntryRequest{
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeReceipt, Quantity: 0},
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeAllocation, Quantity: 1},
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeWaste, Quantity: 11},
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeAdjustment, Quantity: 1},
+	}
+	for _, req := range invalid {
+		if _, err := service.AddStockEntry(&req); !errors.Is(err, persistency.ErrValidation) {
+			t.Errorf("%+v should be invalid, got %v", req, err)
+		}
+	}
+}
+
+func TestFlowersInEventUseFreeStock(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	eventID := newConfirmedEvent(t, dal)
+	dal.CreateEvent(&persistency.Event{ID: "gala", Name: "Gala", Status: contracts.EventStatusConfirmed})
+
+	for _, req := range []contracts.AddStockEntryRequest{
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeReceipt, Quantity: 30},
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeAllocation, Quantity: 10, EventID: "gala"},
+		{FlowerID: "rose", EntryType: contracts.StockEntryTypeReceipt, Quantity: 5, EventID: "gala"},
+	} {
+		if _, err := service.AddStockEntry(&req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	flowerNeeds := func() *contracts.FlowersPackagesResponse {
+		t.Helper()
+		flowers, err := service.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{EventID: eventID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return flowers
+	}
+
+	// 20 of the 30 roses are free, the gala keeps its allocation and the lot received for it
+	flowers := flowerNeeds()
+	if flowers.TotalFlowersFromStock != 20 || flowers.TotalPackages != 2 {
+		t.Errorf("36 roses with 20 in stock should take 2 packages, got %d from stock and %d packages",
+			flowers.TotalFlowersFromStock, flowers.TotalPackages)
+	}
+
+	// allocating to the event keeps the stems counted for it
+	allocation := contracts.AddStockEntryRequest{FlowerID: "rose", EntryType: contracts.StockEntryTypeAllocation, Quantity: 6, EventID: eventID}
+	if _, err := service.AddStockEntry(&allocation); err != nil {
+		t.Fatal(err)
+	}
+	if flowers := flowerNeeds(); flowers.TotalFlowersFromStock != 20 {
+		t.Errorf("the stems allocated to the event should still cover it, got %d from stock", flowers.TotalFlowersFromStock)
+	}
+
+	flowers, err := service.GetFlowersInEvent(&contracts.GetFlowersInEventRequest{EventID: eventID, IgnoreStock: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flowers.TotalFlowersFromStock != 0 || flowers.TotalPackages != 4 {
+		t.Errorf("ignoring the stock should buy every rose, got %d from stock and %d packages", flowers.TotalFlowersFromStock, flowers.TotalPackages)
+	}
+}
+
+func TestFreshAtCutsOffExpiredLots(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	day := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	dal.CreateFlower(&persistency.Flower{ID: "rose", Name: "Rose", ShelfLifeDays: 3}, &[]contracts.PackingOptions{})
+	dal.CreateEvent(&persistency.Event{ID: "wedding", Name: "Wedding", Date: day})
+
+	// the lots expire the day before the event, on the day of the event and the day after
+	for _, receivedAt := range []time.Time{day.AddDate(0, 0, -4), day.AddDate(0, 0, -3), day.AddDate(0, 0, -2)} {
+		req := contracts.AddStockEntryRequest{FlowerID: "rose", EntryType: contracts.StockEntryTypeReceipt, Quantity: 10, ReceivedAt: receivedAt}
+		if _, err := service.AddStockEntry(&req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lots, err := service.GetStockLots(&contracts.GetStockLotsRequest{FreshAt: day})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lots) != 2 || !lots[0].ExpiresAt.Equal(day) {
+		t.Fatalf("a lot expiring on the day should still be fresh and the one expiring before not, got %d lots", len(lots))
+	}
+
+	// allocations skip the lot expired by the event
+	allocation := contracts.AddStockEntryRequest{FlowerID: "rose", EntryType: contracts.StockEntryTypeAllocation, Quantity: 15, EventID: "wedding"}
+	entries, err := service.AddStockEntry(&allocation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].LotID != lots[0].ID || entries[1].LotID != lots[1].ID {
+		t.Errorf("the allocation should come from the lots fresh on the day of the event, got %+v", entries)
+	}
+
+	allocation.Quantity = 6
+	if _, err := service.AddStockEntry(&allocation); err == nil {
+		t.Errorf("the expired lot should not cover an allocation")
+	}
+}
@@ -0,0 +1,26 @@
+package servicecore
+
+import (
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+func (s *ServiceCore) CreateTenant(name string) (string, error) {
+	if err := s.authorize(PermissionManageTenants); err != nil {
+		return "", err
+	}
+
+	tenant := &persistency.Tenant{
+		Name: name,
+	}
+	err := s.DalInstance.CreateTenant(tenant)
+
+	return tenant.ID, err
+}
+
+func (s *ServiceCore) GetTenants() ([]*persistency.Tenant, error) {
+	if err := s.authorize(PermissionManageTenants); err != nil {
+		return nil, err
+	}
+
+	return s.DalInstance.GetTenants()
+}
@@ -0,0 +1,174 @@
+package servicecore
+
+import (
+	"errors"
+	"testing"
+
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"flower-management/internal/persistency/mock"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	north := service.WithTenant("north")
+	south := service.WithTenant("south")
+
+	eventID, err := north.CreateEvent(&contracts.CreateEventRequest{Name: "Wedding"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := north.GetEvent(eventID)
+	if err != nil || event == nil || event.TenantID != "north" {
+		t.Fatalf("a branch should read its own events, got %v, %v", event, err)
+	}
+
+	if event, _ := south.GetEvent(eventID); event != nil {
+		t.Errorf("a branch should not read the events of another branch")
+	}
+	events, _, err := south.GetFilteredEvents(&contracts.GetFilteredEventsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Errorf("a branch should not list the events of another branch, got %d", len(events))
+	}
+	if response, _ := south.Search(&contracts.SearchRequest{Query: "wedding"}); response != nil && len(response.Events) != 0 {
+		t.Errorf("a branch should not find the events of another branch")
+	}
+
+	err = south.TransitionEvent(&contracts.TransitionEventRequest{ID: eventID, Status: contracts.EventStatusQuoted})
+	if err == nil {
+		t.Errorf("a branch should not change the events of another branch")
+	}
+	if err := south.DeleteEvent(eventID); !errors.Is(err, persistency.ErrNotFound) {
+		t.Errorf("deleting the event of another branch should not find it, got %v", err)
+	}
+	if event, _ := north.GetEvent(eventID); event == nil || event.DeletedAt != nil {
+		t.Errorf("deleting from another branch should not touch the event")
+	}
+
+	events, _, _ = north.GetFilteredEvents(&contracts.GetFilteredEventsRequest{})
+	if len(events) != 1 {
+		t.Errorf("the branch should still list its event, got %d", len(events))
+	}
+}
+
+func TestUserActsInItsTenant(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+
+	northID, err := service.WithTenant("north").CreateUser(&contracts.CreateUserRequest{Username: "nora", Password: "north password", Role: contracts.RoleOwner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.WithTenant("south").CreateUser(&contracts.CreateUserRequest{Username: "sam", Password: "south password", Role: contracts.RoleOwner}); err != nil {
+		t.Fatal(err)
+	}
+
+	// logins look users up before their tenant is known
+	user, err := service.WithTenant("").GetUser(northID)
+	if err != nil || user == nil {
+		t.Fatalf("users should be found without a tenant, got %v, %v", user, err)
+	}
+
+	asNora := service.WithUser(user)
+	eventID, err := asNora.CreateEvent(&contracts.CreateEventRequest{Name: "Gala"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event, _ := service.WithTenant("north").GetEvent(eventID); event == nil {
+		t.Errorf("the event should belong to the tenant of the user")
+	}
+
+	users, err := asNora.GetUsers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].ID != northID {
+		t.Errorf("owners should only list the users of their tenant, got %d", len(users))
+	}
+}
+
+func TestDefaultSupplierIsShared(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	north := service.WithTenant("north")
+	south := service.WithTenant("south")
+
+	supplierID, err := north.CreateSupplier(&contracts.CreateSupplierRequest{Name: "Growers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suppliers, err := south.GetFilteredSuppliers(&contracts.GetFilteredSuppliersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(suppliers) != 1 || suppliers[0].ID != persistency.DefaultSupplierID {
+		t.Errorf("other branches should only see the default supplier, got %d suppliers", len(suppliers))
+	}
+	if supplier, _ := south.GetSupplier(supplierID); supplier != nil {
+		t.Errorf("a branch should not read the suppliers of another branch")
+	}
+
+	for _, branch := range []*ServiceCore{service, north, south} {
+		err := branch.EditSupplier(&contracts.EditSupplierRequest{ID: persistency.DefaultSupplierID, Name: "Mine"})
+		if !errors.Is(err, persistency.ErrConflict) {
+			t.Errorf("no branch should edit the default supplier, got %v", err)
+		}
+		if err := branch.DeleteSupplier(persistency.DefaultSupplierID); !errors.Is(err, persistency.ErrConflict) {
+			t.Errorf("no branch should delete the default supplier, got %v", err)
+		}
+	}
+	if supplier, err := south.GetSupplier(persistency.DefaultSupplierID); err != nil || supplier.Name != "Default supplier" {
+		t.Errorf("the default supplier should stay as it was, got %v, %v", supplier, err)
+	}
+}
+
+func TestFlowersArePricedByTheSuppliersOfTheirBranch(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	north := service.WithTenant("north")
+	south := service.WithTenant("south")
+
+	supplierID, err := north.CreateSupplier(&contracts.CreateSupplierRequest{Name: "Growers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, supplierID := range []string{supplierID, persistency.DefaultSupplierID} {
+		_, err := north.CreateFlower(&contracts.CreateFlowerRequest{Name: "Rose", PackingOptions: &[]contracts.PackingOptions{
+			{Quantity: 10, Price: 5, SupplierID: supplierID},
+		}})
+		if err != nil {
+			t.Errorf("a branch should price flowers by its suppliers and the default one, got %v", err)
+		}
+	}
+
+	_, err = south.CreateFlower(&contracts.CreateFlowerRequest{Name: "Rose", PackingOptions: &[]contracts.PackingOptions{
+		{Quantity: 10, Price: 5, SupplierID: supplierID},
+	}})
+	if !errors.Is(err, persistency.ErrNotFound) {
+		t.Errorf("a branch should not price flowers by the suppliers of another branch, got %v", err)
+	}
+}
+
+func TestNoTenantChangesNothing(t *testing.T) {
+	dal := mock.NewDalMock()
+	service := newTestServiceCore(dal)
+	if _, err := service.CreateEvent(&contracts.CreateEventRequest{Name: "Wedding"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// like the row level security of the Dal, without a tenant no row is visible and none can be stored
+	nobody := service.WithTenant("")
+	if events, _, err := nobody.GetFilteredEvents(&contracts.GetFilteredEventsRequest{}); err != nil || len(events) != 0 {
+		t.Errorf("no event should be visible without a tenant, got %d, %v", len(events), err)
+	}
+	if _, err := nobody.CreateEvent(&contracts.CreateEventRequest{Name: "Party"}); !errors.Is(err, persistency.ErrValidation) {
+		t.Errorf("an event should not be stored without a tenant, got %v", err)
+	}
+}
@@ -1,13 +1,31 @@
 package servicesinitializer
 
 import (
+	"bufio"
 	"context"
 	"flower-management/api/rest"
+	"flower-management/api/rpc"
+	"flower-management/contracts"
 	"flower-management/internal/core/config"
 	"flower-management/internal/core/servicecore"
 	persistency "flower-management/internal/persistency/contracts"
 	"flower-management/internal/persistency/dal"
 	"flower-management/internal/persistency/mock"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Actors recorded in the audit log for the changes made by the commands
+const (
+	purgeActor        = "purge"
+	createUserActor   = "create-user"
+	createTenantActor = "create-tenant"
 )
 
 func Execute(envFilename string) {
@@ -16,7 +34,145 @@ func Execute(envFilename string) {
 		panic(err)
 	}
 
+	servicecore := newServiceCore(configSet)
+	defer servicecore.DalInstance.Close()
+
+	// SIGTERM is what container runtimes send before killing the process
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	restServer := rest.NewRestServer(configSet.RestServerConfig, servicecore)
+	if err := restServer.Start(); err != nil {
+		panic(err)
+	}
+
+	grpcServer := rpc.NewGrpcServer(configSet.GrpcServerConfig, servicecore)
+	if err := grpcServer.Start(); err != nil {
+		restServer.Shutdown(context.Background())
+		panic(err)
+	}
+	restServer.MarkReady()
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+	case serveErr = <-restServer.Errors():
+	case serveErr = <-grpcServer.Errors():
+	}
+	// a second signal kills the process right away
+	stop()
+
+	// the servers keep serving while the load balancer notices /readyz failing, a server that failed is
+	// not waited for
+	restServer.MarkStopping()
+	if drainDelay := time.Duration(configSet.LifecycleConfig.DrainDelay) * time.Second; serveErr == nil && drainDelay > 0 {
+		fmt.Printf("Shutting down, draining for %s before closing the listeners\n", drainDelay)
+		time.Sleep(drainDelay)
+	}
+
+	shutdownTimeout := time.Duration(configSet.LifecycleConfig.ShutdownTimeout) * time.Second
+	fmt.Printf("Shutting down, waiting up to %s for the requests in flight\n", shutdownTimeout)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// both servers drain at the same time, the pool is closed once neither runs queries anymore
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if err := restServer.Shutdown(shutdownCtx); err != nil {
+			fmt.Printf("REST server shut down with requests in flight: %v\n", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := grpcServer.Shutdown(shutdownCtx); err != nil {
+			fmt.Printf("gRPC server shut down with calls in flight: %v\n", err)
+		}
+	}()
+	wg.Wait()
+
+	if serveErr != nil {
+		panic(serveErr)
+	}
+}
+
+// Purge permanently removes the flowers, products and events deleted more than olderThanDays days ago
+// in every tenant.
+func Purge(envFilename string, olderThanDays int) {
+	configSet, err := config.LoadConfig(envFilename)
+	if err != nil {
+		panic(err)
+	}
+
+	servicecore := newServiceCore(configSet).AsSystem(purgeActor)
+	defer servicecore.DalInstance.Close()
+
+	tenants, err := servicecore.GetTenants()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, tenant := range tenants {
+		purged, err := servicecore.WithTenant(tenant.ID).PurgeDeleted(olderThanDays)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Purged %d deleted rows older than %d days of %s\n", purged, olderThanDays, tenant.Name)
+	}
+}
+
+// CreateTenant creates a tenant for a new branch of the shop.
+func CreateTenant(envFilename string, name string) {
+	configSet, err := config.LoadConfig(envFilename)
+	if err != nil {
+		panic(err)
+	}
+
+	servicecore := newServiceCore(configSet).AsSystem(createTenantActor)
+	defer servicecore.DalInstance.Close()
+
+	id, err := servicecore.CreateTenant(name)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Created tenant %s with ID %s\n", name, id)
+}
+
+// CreateUser creates a user account, the password is read from the first line of stdin so it does not
+// end up in the shell history. The first owner of a shop is created this way.
+func CreateUser(envFilename string, tenantID string, username string, role string) {
+	configSet, err := config.LoadConfig(envFilename)
+	if err != nil {
+		panic(err)
+	}
+
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		panic(err)
+	}
+
+	servicecore := newServiceCore(configSet).AsSystem(createUserActor).WithTenant(tenantID)
+	defer servicecore.DalInstance.Close()
+
+	id, err := servicecore.CreateUser(&contracts.CreateUserRequest{
+		Username: username,
+		Password: strings.TrimRight(password, "\r\n"),
+		Role:     contracts.Role(role),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Created user %s with ID %s\n", username, id)
+}
+
+func newServiceCore(configSet *config.Config) *servicecore.ServiceCore {
 	var dalInstance persistency.DalInterface
+	var err error
 	if configSet.Mocks.DalMocked {
 		dalInstance = mock.NewDalMock()
 	} else {
@@ -26,12 +182,5 @@ func Execute(envFilename string) {
 		}
 	}
 
-	servicecore := servicecore.NewServiceCore(dalInstance)
-
-	restServer := rest.NewRestServer(configSet.RestServerConfig, servicecore)
-	if err := restServer.Start(); err != nil {
-		panic(err)
-	}
-
-	select {}
+	return servicecore.NewServiceCore(dalInstance, configSet.PackingConfig, configSet.QuoteConfig, configSet.AuthConfig)
 }
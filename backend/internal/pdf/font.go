@@ -0,0 +1,414 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	_ "embed"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// The DejaVu Sans Condensed fonts cover Latin, Greek, Cyrillic, Hebrew and Arabic, see fonts/LICENSE
+var (
+	//go:embed fonts/DejaVuSansCondensed.ttf
+	regularTTF []byte
+	//go:embed fonts/DejaVuSansCondensed-Bold.ttf
+	boldTTF []byte
+
+	regularFont = mustParseFont("DejaVuSansCondensed", regularTTF)
+	boldFont    = mustParseFont("DejaVuSansCondensed-Bold", boldTTF)
+)
+
+// trueTypeFont holds what a PDF needs of a TrueType font: the glyph of every character, the advance
+// width of every glyph and the tables a subset of the font is built from
+type trueTypeFont struct {
+	name       string
+	tables     map[string][]byte
+	unitsPerEm int
+	ascent     int
+	descent    int
+	bbox       [4]int
+	advances   []int
+	glyphs     map[rune]uint16
+}
+
+func mustParseFont(name string, data []byte) *trueTypeFont {
+	font, err := parseFont(name, data)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse font %s: %v", name, err))
+	}
+	return font
+}
+
+func parseFont(name string, data []byte) (*trueTypeFont, error) {
+	if len(data) < 12 {
+		return nil, fmt.Errorf("font file is too short")
+	}
+	font := &trueTypeFont{name: name, tables: make(map[string][]byte)}
+
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < numTables; i++ {
+		entry := data[12+16*i:]
+		offset, length := binary.BigEndian.Uint32(entry[8:]), binary.BigEndian.Uint32(entry[12:])
+		if int(offset+length) > len(data) {
+			return nil, fmt.Errorf("table %s is out of bounds", entry[:4])
+		}
+		font.tables[string(entry[:4])] = data[offset : offset+length]
+	}
+	for _, tag := range []string{"head", "hhea", "hmtx", "maxp", "cmap", "loca", "glyf"} {
+		if font.tables[tag] == nil {
+			return nil, fmt.Errorf("missing table %s", tag)
+		}
+	}
+
+	head := font.tables["head"]
+	font.unitsPerEm = int(binary.BigEndian.Uint16(head[18:]))
+	for i := range font.bbox {
+		font.bbox[i] = int(int16(binary.BigEndian.Uint16(head[36+2*i:])))
+	}
+
+	hhea := font.tables["hhea"]
+	font.ascent = int(int16(binary.BigEndian.Uint16(hhea[4:])))
+	font.descent = int(int16(binary.BigEndian.Uint16(hhea[6:])))
+	numberOfHMetrics := int(binary.BigEndian.Uint16(hhea[34:]))
+
+	numGlyphs := int(binary.BigEndian.Uint16(font.tables["maxp"][4:]))
+	hmtx := font.tables["hmtx"]
+	font.advances = make([]int, numGlyphs)
+	for g := range font.advances {
+		if g < numberOfHMetrics {
+			font.advances[g] = int(binary.BigEndian.Uint16(hmtx[4*g:]))
+		} else {
+			font.advances[g] = font.advances[numberOfHMetrics-1]
+		}
+	}
+
+	glyphs, err := parseCmap(font.tables["cmap"])
+	if err != nil {
+		return nil, err
+	}
+	font.glyphs = glyphs
+
+	return font, nil
+}
+
+// parseCmap reads the Unicode subtable of the cmap, the full repertoire one when the font has it
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	var format4, format12 []byte
+	numSubtables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < numSubtables; i++ {
+		record := cmap[4+8*i:]
+		platformID, encodingID := binary.BigEndian.Uint16(record), binary.BigEndian.Uint16(record[2:])
+		subtable := cmap[binary.BigEndian.Uint32(record[4:]):]
+		switch {
+		case platformID == 3 && encodingID == 10 && binary.BigEndian.Uint16(subtable) == 12:
+			format12 = subtable
+		case platformID == 3 && encodingID == 1 && binary.BigEndian.Uint16(subtable) == 4:
+			format4 = subtable
+		}
+	}
+
+	glyphs := make(map[rune]uint16)
+	switch {
+	case format12 != nil:
+		numGroups := int(binary.BigEndian.Uint32(format12[12:]))
+		for i := 0; i < numGroups; i++ {
+			group := format12[16+12*i:]
+			start, end := binary.BigEndian.Uint32(group), binary.BigEndian.Uint32(group[4:])
+			glyph := binary.BigEndian.Uint32(group[8:])
+			for c := start; c <= end; c++ {
+				glyphs[rune(c)] = uint16(glyph + c - start)
+			}
+		}
+	case format4 != nil:
+		segCountX2 := int(binary.BigEndian.Uint16(format4[6:]))
+		endCodes := format4[14:]
+		startCodes := format4[16+segCountX2:]
+		idDeltas := format4[16+2*segCountX2:]
+		idRangeOffsets := format4[16+3*segCountX2:]
+		for i := 0; i < segCountX2; i += 2 {
+			start, end := int(binary.BigEndian.Uint16(startCodes[i:])), int(binary.BigEndian.Uint16(endCodes[i:]))
+			delta := binary.BigEndian.Uint16(idDeltas[i:])
+			rangeOffset := int(binary.BigEndian.Uint16(idRangeOffsets[i:]))
+			for c := start; c <= end && c != 0xffff; c++ {
+				glyph := uint16(c) + delta
+				if rangeOffset != 0 {
+					glyph = binary.BigEndian.Uint16(idRangeOffsets[i+rangeOffset+2*(c-start):])
+					if glyph != 0 {
+						glyph += delta
+					}
+				}
+				if glyph != 0 {
+					glyphs[rune(c)] = glyph
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("no unicode cmap subtable")
+	}
+
+	return glyphs, nil
+}
+
+// glyph returns the glyph of a character, characters the font lacks are drawn as '?'
+func (f *trueTypeFont) glyph(r rune) uint16 {
+	if glyph, ok := f.glyphs[r]; ok {
+		return glyph
+	}
+	return f.glyphs['?']
+}
+
+// width returns the advance width of a glyph in thousandths of the font size
+func (f *trueTypeFont) width(glyph uint16) int {
+	return f.advances[glyph] * 1000 / f.unitsPerEm
+}
+
+// glyphData returns the outline of a glyph in the glyf table
+func (f *trueTypeFont) glyphData(glyph uint16) []byte {
+	loca := f.tables["loca"]
+	var start, end uint32
+	if binary.BigEndian.Uint16(f.tables["head"][50:]) == 0 {
+		start = uint32(binary.BigEndian.Uint16(loca[2*int(glyph):])) * 2
+		end = uint32(binary.BigEndian.Uint16(loca[2*int(glyph)+2:])) * 2
+	} else {
+		start = binary.BigEndian.Uint32(loca[4*int(glyph):])
+		end = binary.BigEndian.Uint32(loca[4*int(glyph)+4:])
+	}
+	return f.tables["glyf"][start:end]
+}
+
+// components returns the glyphs a composite glyph is built from
+func components(glyphData []byte) []uint16 {
+	if len(glyphData) < 10 || int16(binary.BigEndian.Uint16(glyphData)) >= 0 {
+		return nil
+	}
+
+	const (
+		argsAreWords  = 0x0001
+		haveScale     = 0x0008
+		moreComponent = 0x0020
+		haveXYScale   = 0x0040
+		haveTwoByTwo  = 0x0080
+	)
+	var glyphs []uint16
+	for offset := 10; offset+4 <= len(glyphData); {
+		flags := binary.BigEndian.Uint16(glyphData[offset:])
+		glyphs = append(glyphs, binary.BigEndian.Uint16(glyphData[offset+2:]))
+		offset += 4
+		if flags&argsAreWords != 0 {
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			offset += 2
+		case flags&haveXYScale != 0:
+			offset += 4
+		case flags&haveTwoByTwo != 0:
+			offset += 8
+		}
+		if flags&moreComponent == 0 {
+			break
+		}
+	}
+	return glyphs
+}
+
+// subset returns a font file holding only the outlines of the used glyphs and of the glyphs they are
+// composed of. The other glyphs are left empty so the glyph ids, and the widths, stay the same.
+func (f *trueTypeFont) subset(used map[uint16]rune) []byte {
+	keep := make(map[uint16]bool)
+	pending := []uint16{0}
+	for glyph := range used {
+		pending = append(pending, glyph)
+	}
+	for len(pending) > 0 {
+		glyph := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if keep[glyph] {
+			continue
+		}
+		keep[glyph] = true
+		pending = append(pending, components(f.glyphData(glyph))...)
+	}
+
+	var glyf bytes.Buffer
+	loca := make([]byte, 4*(len(f.advances)+1))
+	for glyph := range f.advances {
+		binary.BigEndian.PutUint32(loca[4*glyph:], uint32(glyf.Len()))
+		if keep[uint16(glyph)] {
+			glyf.Write(f.glyphData(uint16(glyph)))
+			for glyf.Len()%4 != 0 {
+				glyf.WriteByte(0)
+			}
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*len(f.advances):], uint32(glyf.Len()))
+
+	// the subset uses long offsets in loca, and its checksum adjustment is set once the file is complete
+	head := bytes.Clone(f.tables["head"])
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	tables := map[string][]byte{
+		"glyf": glyf.Bytes(),
+		"head": head,
+		"hhea": f.tables["hhea"],
+		"hmtx": f.tables["hmtx"],
+		"loca": loca,
+		"maxp": f.tables["maxp"],
+	}
+	for _, tag := range []string{"OS/2", "cmap", "cvt ", "fpgm", "prep"} {
+		if f.tables[tag] != nil {
+			tables[tag] = f.tables[tag]
+		}
+	}
+
+	// the post table keeps its metrics and drops the glyph names
+	if post := f.tables["post"]; len(post) >= 32 {
+		tables["post"] = bytes.Clone(post[:32])
+		binary.BigEndian.PutUint32(tables["post"], 0x00030000)
+	}
+
+	out, offsets := writeFontFile(tables)
+	binary.BigEndian.PutUint32(out[offsets["head"]+8:], 0xB1B0AFBA-checksum(out))
+
+	return out
+}
+
+// writeFontFile lays out the tables after the table directory, sorted by tag, and returns where each
+// table starts
+func writeFontFile(tables map[string][]byte) ([]byte, map[string]int) {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= len(tags) {
+		entrySelector++
+	}
+	searchRange := (1 << entrySelector) * 16
+
+	var out bytes.Buffer
+	binary.Write(&out, binary.BigEndian, []uint32{0x00010000})
+	binary.Write(&out, binary.BigEndian, []uint16{
+		uint16(len(tags)), uint16(searchRange), uint16(entrySelector), uint16(len(tags)*16 - searchRange),
+	})
+
+	offsets := make(map[string]int, len(tags))
+	offset := 12 + 16*len(tags)
+	for _, tag := range tags {
+		offsets[tag] = offset
+		out.WriteString(tag)
+		binary.Write(&out, binary.BigEndian, []uint32{checksum(tables[tag]), uint32(offset), uint32(len(tables[tag]))})
+		offset += (len(tables[tag]) + 3) &^ 3
+	}
+	for _, tag := range tags {
+		out.Write(tables[tag])
+		for out.Len()%4 != 0 {
+			out.WriteByte(0)
+		}
+	}
+
+	return out.Bytes(), offsets
+}
+
+// checksum sums the data as big endian 32 bit words, the last one padded with zeros
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// fontObjects returns the five objects that embed a subset of the font, starting at object number
+// first: the Type0 font, its descendant CIDFont, the font descriptor, the font file and the ToUnicode map
+func (f *trueTypeFont) fontObjects(first int, used map[uint16]rune) []string {
+	glyphs := make([]uint16, 0, len(used))
+	for glyph := range used {
+		glyphs = append(glyphs, glyph)
+	}
+	sort.Slice(glyphs, func(i, j int) bool { return glyphs[i] < glyphs[j] })
+
+	// subsets are named with a tag of six capital letters derived from their glyphs
+	var ids bytes.Buffer
+	binary.Write(&ids, binary.BigEndian, glyphs)
+	hash := crc32.ChecksumIEEE(append(ids.Bytes(), f.name...))
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + byte(hash%26)
+		hash /= 26
+	}
+	baseFont := string(tag) + "+" + f.name
+
+	var widths strings.Builder
+	for _, glyph := range glyphs {
+		fmt.Fprintf(&widths, "%d [%d] ", glyph, f.width(glyph))
+	}
+
+	scale := func(v int) int { return v * 1000 / f.unitsPerEm }
+	fontFile := f.subset(used)
+
+	return []string{
+		fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+			baseFont, first+1, first+4),
+		fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+			"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /W [%s] /CIDToGIDMap /Identity >>",
+			baseFont, first+2, strings.TrimSpace(widths.String())),
+		fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 "+
+			"/Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+			baseFont, scale(f.bbox[0]), scale(f.bbox[1]), scale(f.bbox[2]), scale(f.bbox[3]),
+			scale(f.ascent), scale(f.descent), scale(f.ascent), first+3),
+		compressedStream(fontFile, fmt.Sprintf("/Length1 %d", len(fontFile))),
+		stream(toUnicode(glyphs, used)),
+	}
+}
+
+// toUnicode maps the glyphs back to their characters, so text can be searched and copied
+func toUnicode(glyphs []uint16, used map[uint16]rune) string {
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+
+	// a bfchar block holds at most 100 mappings
+	for start := 0; start < len(glyphs); start += 100 {
+		block := glyphs[start:min(start+100, len(glyphs))]
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(block))
+		for _, glyph := range block {
+			fmt.Fprintf(&b, "<%04X> <", glyph)
+			for _, unit := range utf16.Encode([]rune{used[glyph]}) {
+				fmt.Fprintf(&b, "%04X", unit)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.String()
+}
+
+func stream(content string) string {
+	return fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content)
+}
+
+func compressedStream(content []byte, entries string) string {
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	w.Write(content)
+	w.Close()
+	return fmt.Sprintf("<< /Length %d /Filter /FlateDecode %s >>\nstream\n%s\nendstream", compressed.Len(), entries, compressed.Bytes())
+}
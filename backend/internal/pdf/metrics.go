@@ -0,0 +1,31 @@
+package pdf
+
+// TextWidth returns the width of text in points when set in the regular or bold font at the given size.
+func TextWidth(text string, size float64, bold bool) float64 {
+	font := fontFor(bold)
+
+	total := 0
+	for _, r := range text {
+		total += font.width(font.glyph(printable(r)))
+	}
+
+	return float64(total) * size / 1000
+}
+
+// printable replaces the characters a line cannot hold, line breaks and tabs become spaces
+func printable(r rune) rune {
+	if r == '\n' || r == '\r' || r == '\t' {
+		return ' '
+	}
+	if r < 0x20 || r == 0x7f {
+		return '?'
+	}
+	return r
+}
+
+func fontFor(bold bool) *trueTypeFont {
+	if bold {
+		return boldFont
+	}
+	return regularFont
+}
@@ -0,0 +1,232 @@
+// Package pdf renders simple text documents - headings, paragraphs and tables - as PDF files.
+// Text is set in the embedded DejaVu Sans Condensed fonts, so Hebrew and most other scripts render
+// and no external service is needed. Each document embeds the subset of the fonts it uses, its text
+// is written as glyph ids with the Identity-H encoding, characters the fonts lack are replaced with '?'.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page size and margins in points
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+	Margin     = 50.0
+)
+
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+	lineSpacing = 1.4
+)
+
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+)
+
+// Column describes a table column, X is relative to the left margin
+type Column struct {
+	Title string
+	X     float64
+	Width float64
+	Align Align
+}
+
+type Document struct {
+	pages []*bytes.Buffer
+	y     float64
+	// used maps the glyphs drawn with each font to the character they stand for
+	used map[*trueTypeFont]map[uint16]rune
+}
+
+func NewDocument() *Document {
+	d := &Document{used: map[*trueTypeFont]map[uint16]rune{regularFont: {}, boldFont: {}}}
+	d.AddPage()
+	return d
+}
+
+// AddPage starts a new page and moves the cursor to its top margin.
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = Margin
+}
+
+// Heading writes a bold line of text.
+func (d *Document) Heading(text string, size float64) {
+	d.ensureSpace(size * lineSpacing)
+	d.text(Margin, d.y+size, size, true, text)
+	d.y += size * lineSpacing
+}
+
+// Paragraph writes a line of text in the regular font.
+func (d *Document) Paragraph(text string, size float64) {
+	d.ensureSpace(size * lineSpacing)
+	d.text(Margin, d.y+size, size, false, text)
+	d.y += size * lineSpacing
+}
+
+// KeyValue writes a bold label and its value on the same line, the value starts at valueX.
+func (d *Document) KeyValue(label, value string, valueX, size float64) {
+	d.ensureSpace(size * lineSpacing)
+	d.text(Margin, d.y+size, size, true, label)
+	d.text(Margin+valueX, d.y+size, size, false, value)
+	d.y += size * lineSpacing
+}
+
+// Space moves the cursor down.
+func (d *Document) Space(height float64) {
+	d.y += height
+}
+
+// Rule draws a horizontal line across the page.
+func (d *Document) Rule() {
+	d.ensureSpace(6)
+	d.line(Margin, d.y+3, PageWidth-Margin, d.y+3)
+	d.y += 6
+}
+
+// Table writes a header row and the rows below it. When a page fills up the table continues on
+// the next page, starting again with the header.
+func (d *Document) Table(columns []Column, rows [][]string, size float64) {
+	rowHeight := size * lineSpacing
+
+	d.ensureSpace(rowHeight * 2)
+	d.tableHeader(columns, size)
+	for _, row := range rows {
+		if d.y+rowHeight > PageHeight-Margin {
+			d.AddPage()
+			d.tableHeader(columns, size)
+		}
+		d.tableRow(columns, row, size, false)
+	}
+}
+
+// TotalRow writes a bold row, used below a table.
+func (d *Document) TotalRow(columns []Column, row []string, size float64) {
+	d.ensureSpace(size * lineSpacing)
+	d.tableRow(columns, row, size, true)
+}
+
+func (d *Document) tableHeader(columns []Column, size float64) {
+	titles := make([]string, len(columns))
+	for i, column := range columns {
+		titles[i] = column.Title
+	}
+	d.tableRow(columns, titles, size, true)
+	d.line(Margin, d.y, PageWidth-Margin, d.y)
+	d.y += 2
+}
+
+func (d *Document) tableRow(columns []Column, row []string, size float64, bold bool) {
+	for i, column := range columns {
+		if i >= len(row) {
+			break
+		}
+		text := fitText(row[i], column.Width, size, bold)
+		x := Margin + column.X
+		if column.Align == AlignRight {
+			x += column.Width - TextWidth(text, size, bold)
+		}
+		d.text(x, d.y+size, size, bold, text)
+	}
+	d.y += size * lineSpacing
+}
+
+func (d *Document) ensureSpace(height float64) {
+	if d.y+height > PageHeight-Margin {
+		d.AddPage()
+	}
+}
+
+// text draws text with its baseline at y, measured from the top of the page
+func (d *Document) text(x, y, size float64, bold bool, text string) {
+	name := fontRegular
+	if bold {
+		name = fontBold
+	}
+	fmt.Fprintf(d.pages[len(d.pages)-1], "BT /%s %.2f Tf %.2f %.2f Td <%s> Tj ET\n", name, size, x, PageHeight-y, d.encode(fontFor(bold), text))
+}
+
+// encode returns the glyph ids of text in drawing order as a hex string, and records the glyphs as used
+func (d *Document) encode(font *trueTypeFont, text string) string {
+	var b strings.Builder
+	for _, r := range visualOrder(text) {
+		r = printable(r)
+		if _, ok := font.glyphs[r]; !ok {
+			r = '?'
+		}
+		glyph := font.glyph(r)
+		d.used[font][glyph] = r
+		fmt.Fprintf(&b, "%04X", glyph)
+	}
+	return b.String()
+}
+
+func (d *Document) line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(d.pages[len(d.pages)-1], "0.5 w %.2f %.2f m %.2f %.2f l S\n", x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+// Bytes serializes the document. Objects 1 and 2 are the catalog and the page tree, objects 3 to 7 and
+// 8 to 12 embed the regular and the bold font, every page then takes a page object followed by its
+// content stream.
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	var offsets []int
+
+	writeObject := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	const firstPage = 13
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+i*2)
+	}
+
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for _, object := range regularFont.fontObjects(3, d.used[regularFont]) {
+		writeObject(object)
+	}
+	for _, object := range boldFont.fontObjects(8, d.used[boldFont]) {
+		writeObject(object)
+	}
+
+	for i, content := range d.pages {
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /%s 3 0 R /%s 8 0 R >> >> /Contents %d 0 R >>",
+			PageWidth, PageHeight, fontRegular, fontBold, firstPage+1+i*2,
+		))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+// fitText cuts text that does not fit in width, ending it with "..."
+func fitText(text string, width, size float64, bold bool) string {
+	if width <= 0 || TextWidth(text, size, bold) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && TextWidth(string(runes)+"...", size, bold) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
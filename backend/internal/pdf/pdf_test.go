@@ -0,0 +1,110 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestDocumentBytes(t *testing.T) {
+	doc := NewDocument()
+	doc.Heading("Quote (draft)", 18)
+	columns := []Column{{Title: "Product", X: 0, Width: 200}, {Title: "Total", X: 300, Width: 80, Align: AlignRight}}
+	rows := make([][]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		rows = append(rows, []string{fmt.Sprintf("Product %d", i), "10.00"})
+	}
+	doc.Table(columns, rows, 10)
+
+	out := doc.Bytes()
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("output is not framed as a PDF file")
+	}
+	if !bytes.Contains(out, []byte("<"+doc.encode(boldFont, "Quote (draft)")+"> Tj")) {
+		t.Errorf("the heading is not drawn as glyph ids")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Errorf("a 100 row table should span two pages")
+	}
+
+	// every xref entry must point at the start of its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if startxref == nil {
+		t.Fatalf("missing startxref")
+	}
+	xref, _ := strconv.Atoi(string(startxref[1]))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		want := fmt.Sprintf("%d 0 obj", i+1)
+		if !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q, want %q", i+1, out[offset:offset+len(want)], want)
+		}
+	}
+}
+
+func TestVisualOrder(t *testing.T) {
+	tests := map[string]string{
+		"Rose":              "Rose",
+		"café":              "café",
+		"שלום":              "םולש",
+		"Bouquet ורדים":     "Bouquet םידרו",
+		"חתונה 2026":        "2026 הנותח",
+		"חתונה (12.5) Gala": "(12.5) הנותח Gala",
+		"x\ny":              "x\ny",
+	}
+	for in, want := range tests {
+		if got := string(visualOrder(in)); got != want {
+			t.Errorf("visualOrder(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestUnicodeTextIsEmbedded(t *testing.T) {
+	doc := NewDocument()
+	doc.Paragraph("שלום", 12)
+	doc.Paragraph("€ \u0080 \u0400", 12)
+	out := doc.Bytes()
+
+	for _, r := range "שלום€Ѐ" {
+		glyph, ok := regularFont.glyphs[r]
+		if !ok {
+			t.Errorf("the font has no glyph for %q", r)
+			continue
+		}
+		if doc.used[regularFont][glyph] != r {
+			t.Errorf("the glyph of %q is not embedded", r)
+		}
+		if !bytes.Contains(out, []byte(fmt.Sprintf("<%04X> <%04X>", glyph, r))) {
+			t.Errorf("the ToUnicode map does not map %q back", r)
+		}
+	}
+	if doc.used[regularFont][regularFont.glyph('?')] != '?' {
+		t.Errorf("a control character should be drawn as '?'")
+	}
+	if !bytes.Contains(out, []byte("/Encoding /Identity-H")) || !bytes.Contains(out, []byte("/FontFile2")) {
+		t.Errorf("the fonts are not embedded as Identity-H CID fonts")
+	}
+}
+
+func TestSubsetKeepsOnlyUsedGlyphs(t *testing.T) {
+	used := map[uint16]rune{regularFont.glyph('a'): 'a'}
+	subset, err := parseFont("subset", regularFont.subset(used))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subset.glyphData(regularFont.glyph('a'))) == 0 {
+		t.Errorf("the used glyph lost its outline")
+	}
+	if len(subset.glyphData(regularFont.glyph('b'))) != 0 {
+		t.Errorf("an unused glyph kept its outline")
+	}
+	if len(regularFont.subset(used)) > len(regularTTF)/4 {
+		t.Errorf("the subset of one glyph should be far smaller than the font")
+	}
+	if checksum(regularFont.subset(used)) != 0xB1B0AFBA {
+		t.Errorf("the checksum adjustment of the subset is wrong")
+	}
+}
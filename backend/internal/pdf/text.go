@@ -0,0 +1,88 @@
+package pdf
+
+import "golang.org/x/text/unicode/bidi"
+
+// mirrored pairs the brackets that swap when right to left text is reversed
+var mirrored = map[rune]rune{'(': ')', ')': '(', '[': ']', ']': '[', '{': '}', '}': '{', '<': '>', '>': '<', '«': '»', '»': '«'}
+
+// visualOrder returns the characters of a line in the order they are drawn. Lines run left to right,
+// a run of right to left text such as Hebrew is reversed, keeping the numbers in it left to right and
+// mirroring its brackets. It follows the bidirectional algorithm closely enough for the short lines
+// of a document: names, labels and numbers.
+func visualOrder(text string) []rune {
+	runes := []rune(text)
+	visual := make([]rune, 0, len(runes))
+	for start := 0; start < len(runes); {
+		if class(runes[start]) != bidi.R && class(runes[start]) != bidi.AL {
+			visual = append(visual, runes[start])
+			start++
+			continue
+		}
+
+		end := rightToLeftRunEnd(runes, start)
+		visual = append(visual, reverseRun(runes[start:end])...)
+		start = end
+	}
+	return visual
+}
+
+// rightToLeftRunEnd returns where the right to left run starting at start ends: after its last right to
+// left character or number before left to right text, or after the bracket closing one opened in the run
+func rightToLeftRunEnd(runes []rune, start int) int {
+	end := start + 1
+	open := 0
+	for i := start; i < len(runes); i++ {
+		switch c := class(runes[i]); {
+		case c == bidi.L:
+			return end
+		case c == bidi.R || c == bidi.AL || c == bidi.EN || c == bidi.AN:
+			end = i + 1
+		case isOpening(runes[i]):
+			open++
+		case mirrored[runes[i]] != 0 && open > 0:
+			open--
+			end = i + 1
+		}
+	}
+	return end
+}
+
+// reverseRun reverses a right to left run, numbers keep their digits in reading order
+func reverseRun(run []rune) []rune {
+	reversed := make([]rune, 0, len(run))
+	for end := len(run); end > 0; {
+		start := end - 1
+		if isNumber(run[start]) {
+			for start > 0 && (isNumber(run[start-1]) || isSeparator(run[start-1]) && start > 1 && isNumber(run[start-2])) {
+				start--
+			}
+			reversed = append(reversed, run[start:end]...)
+		} else if r, ok := mirrored[run[start]]; ok {
+			reversed = append(reversed, r)
+		} else {
+			reversed = append(reversed, run[start])
+		}
+		end = start
+	}
+	return reversed
+}
+
+func class(r rune) bidi.Class {
+	properties, _ := bidi.LookupRune(r)
+	return properties.Class()
+}
+
+func isNumber(r rune) bool {
+	c := class(r)
+	return c == bidi.EN || c == bidi.AN
+}
+
+func isSeparator(r rune) bool {
+	c := class(r)
+	return c == bidi.CS || c == bidi.ES || c == bidi.ET
+}
+
+func isOpening(r rune) bool {
+	properties, _ := bidi.LookupRune(r)
+	return properties.IsOpeningBracket() || r == '<' || r == '«'
+}
@@ -1,13 +1,33 @@
 package contracts
 
 import (
+	"context"
+	"encoding/json"
 	"flower-management/contracts"
 	"time"
 )
 
+// DefaultSupplierID is the supplier of packing options created without an explicit supplier
+const DefaultSupplierID = "00000000-0000-0000-0000-000000000001"
+
+// DefaultTenantID is the shop the rows stored before tenants existed belong to
+const DefaultTenantID = "00000000-0000-0000-0000-000000000001"
+
+// Tenant is a branch of the shop, every entity belongs to exactly one tenant
+type Tenant struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
 type Flower struct {
-	ID   string
-	Name string
+	ID       string
+	TenantID string
+	Name     string
+	// ShelfLifeDays is how long a received flower stays fresh, 0 when it does not expire
+	ShelfLifeDays int
+	// DeletedAt is set once the flower is deleted, it can be restored until it is purged
+	DeletedAt *time.Time
 }
 
 type FlowerInProduct struct {
@@ -16,26 +36,53 @@ type FlowerInProduct struct {
 	NumOfFlowers int
 }
 
+type Supplier struct {
+	ID       string
+	TenantID string
+	Name     string
+	Phone    string
+	Email    string
+	Address  string
+}
+
 type FlowerPackageOptions struct {
 	FlowerID     string
+	SupplierID   string
 	NumOfFlowers int
 	Price        float64
 }
 
 type Product struct {
 	ID          string
+	TenantID    string
 	Name        string
 	Description string
+	DeletedAt   *time.Time
 }
 
+// Event keeps its own contact details and address, the customer is optional
 type Event struct {
 	ID          string
+	TenantID    string
 	Name        string
 	Date        time.Time
+	Status      contracts.EventStatus
+	CustomerID  string
 	Phone       string
 	Email       string
 	Address     string
 	Description string
+	DeletedAt   *time.Time
+}
+
+type Customer struct {
+	ID       string
+	TenantID string
+	Name     string
+	Phone    string
+	Email    string
+	Address  string
+	Notes    string
 }
 
 type EventProduct struct {
@@ -44,27 +91,245 @@ type EventProduct struct {
 	Quantity  int
 }
 
+type PurchaseOrder struct {
+	ID            string
+	TenantID      string
+	EventID       string
+	SupplierID    string
+	Status        contracts.PurchaseOrderStatus
+	TotalPrice    float64
+	TotalPackages int
+	TotalFlowers  int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Lines         []*PurchaseOrderLine
+}
+
+type PurchaseOrderLine struct {
+	PurchaseOrderID       string
+	FlowerID              string
+	FlowerName            string
+	NumOfFlowersInPackage int
+	NumOfPackages         int
+	Price                 float64
+}
+
+type StockEntry struct {
+	ID        string
+	TenantID  string
+	FlowerID  string
+	LotID     string
+	EntryType contracts.StockEntryType
+	// Quantity is signed: positive entries add flowers to the stock, negative ones take them out
+	Quantity  int
+	EventID   string
+	Note      string
+	CreatedAt time.Time
+}
+
+type StockLot struct {
+	ID         string
+	TenantID   string
+	FlowerID   string
+	ReceivedAt time.Time
+	// ExpiresAt is nil for flowers without a shelf life
+	ExpiresAt *time.Time
+	Quantity  int
+	Remaining int
+}
+
+type StockLevel struct {
+	FlowerID string
+	OnHand   int
+}
+
+// Quote is a frozen snapshot of the prices of an event, later edits of the event do not change it
+type Quote struct {
+	ID            string
+	TenantID      string
+	EventID       string
+	Version       int
+	Status        contracts.QuoteStatus
+	Strategy      contracts.PackingStrategy
+	EventName     string
+	EventDate     time.Time
+	MarkupPercent float64
+	TaxPercent    float64
+	Subtotal      float64
+	Tax           float64
+	GrandTotal    float64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	LineItems     []*QuoteLineItem
+}
+
+type QuoteLineItem struct {
+	QuoteID           string
+	ProductID         string
+	ProductName       string
+	Quantity          int
+	FlowerCostPerUnit float64
+	LaborCostPerUnit  float64
+	UnitPrice         float64
+	LineTotal         float64
+}
+
+// AuditRecord is an immutable record of a change, Before is null for a create and After for a delete
+type AuditRecord struct {
+	ID         string
+	TenantID   string
+	Actor      string
+	CreatedAt  time.Time
+	EntityType contracts.AuditEntityType
+	EntityID   string
+	Action     contracts.AuditAction
+	Before     json.RawMessage
+	After      json.RawMessage
+}
+
+type User struct {
+	ID           string
+	TenantID     string
+	Username     string
+	PasswordHash string `json:"-"`
+	Role         contracts.Role
+	CreatedAt    time.Time
+}
+
+// APIToken is a long lived token of a user, only the SHA-256 hash of the token is stored
+type APIToken struct {
+	ID        string
+	UserID    string
+	Name      string
+	TokenHash string `json:"-"`
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// SearchHit is a row matching a full text search
+type SearchHit struct {
+	EntityType contracts.SearchEntityType
+	ID         string
+	Title      string
+	Snippet    string
+	Rank       float64
+}
+
+// MigrationStatus compares the changesets applied to the database with those of the changelog
+type MigrationStatus struct {
+	Applied int
+	// Latest is the ID of the last changeset applied
+	Latest string
+	// Pending lists the IDs of the changesets of the changelog the database is missing
+	Pending []string
+}
+
+// PoolStats describes the connections of the pool at the time of the check
+type PoolStats struct {
+	MaxConns      int
+	TotalConns    int
+	IdleConns     int
+	AcquiredConns int
+	AcquireCount  int64
+	// EmptyAcquireCount counts the acquires that had to wait for a connection
+	EmptyAcquireCount int64
+	AcquireDuration   time.Duration
+}
+
+type Health struct {
+	Migrations MigrationStatus
+	Pool       PoolStats
+}
+
+// HealthChecker checks the database the persistency depends on
+type HealthChecker interface {
+	// CheckHealth returns an error when the database does not answer, what could be found is returned either way
+	CheckHealth(ctx context.Context) (*Health, error)
+}
+
 type DalInterface interface {
+	HealthChecker
+	// WithActor returns a DalInterface that records its changes in the audit log as made by actor
+	WithActor(actor string) DalInterface
+	// WithTenant returns a DalInterface that only sees and changes the rows of the tenant
+	WithTenant(tenantID string) DalInterface
+	// Close releases the connections shared by the DalInterface and every DalInterface derived from it
+	Close()
+	CreateTenant(tenant *Tenant) error
+	GetTenant(id string) (*Tenant, error)
+	GetTenants() ([]*Tenant, error)
+	GetAuditRecords(req *contracts.GetAuditRecordsRequest) ([]*AuditRecord, error)
 	CreateFlower(flower *Flower, packingOptions *[]contracts.PackingOptions) error
 	CreateProduct(product *Product) error
 	CreateEvent(event *Event) error
+	CreateSupplier(supplier *Supplier) error
 	EditFlower(flower *Flower) error
 	EditProduct(product *Product) error
 	EditEvent(event *Event) error
+	EditSupplier(supplier *Supplier) error
 	DeleteFlower(id string) error
 	DeleteProduct(id string) error
 	DeleteEvent(id string) error
-	GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*Flower, error)
-	GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*Product, error)
-	GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*Event, error)
+	RestoreFlower(id string) error
+	RestoreProduct(id string) error
+	RestoreEvent(id string) error
+	// PurgeDeleted permanently removes the flowers, products and events of the tenant deleted before the
+	// given time, the ones still referenced are kept
+	PurgeDeleted(before time.Time) (int, error)
+	DeleteSupplier(id string) error
+	GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*Flower, *Page, error)
+	GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*Product, *Page, error)
+	GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*Event, *Page, error)
+	GetFilteredSuppliers(req *contracts.GetFilteredSuppliersRequest) ([]*Supplier, error)
 	GetEvent(id string) (*Event, error)
 	GetProduct(id string) (*Product, error)
 	GetFlower(id string) (*Flower, error)
+	GetSupplier(id string) (*Supplier, error)
 	AddFlowersToProduct(req *contracts.AddFlowersToProductRequest) error
 	AddProductsToEvent(req *contracts.AddProductsToEventRequest) error
 	EditFlowersInProduct(req *contracts.AddFlowersToProductRequest) error
 	EditProductsInEvent(req *contracts.AddProductsToEventRequest) error
 	GetProductsFromEvent(eventID string) ([]*EventProduct, error)
+	UpdateEventStatus(id string, status contracts.EventStatus) error
 	GetFlowersFromProduct(productID string) ([]*FlowerInProduct, error)
 	GetFlowerPackingOptions(flowerID string) ([]*FlowerPackageOptions, error)
+	// GetProductsFromEvents, GetFlowersFromProducts and GetFlowersPackingOptions load the relations of
+	// several rows at once, so nested reads do not need a query per row
+	GetProductsFromEvents(eventIDs []string) ([]*EventProduct, error)
+	GetFlowersFromProducts(productIDs []string) ([]*FlowerInProduct, error)
+	GetFlowersPackingOptions(flowerIDs []string) ([]*FlowerPackageOptions, error)
+	SetFlowerPackingOptions(req *contracts.SetFlowerPackingOptionsRequest) error
+	CreatePurchaseOrders(eventID string, purchaseOrders []*PurchaseOrder) error
+	GetPurchaseOrder(id string) (*PurchaseOrder, error)
+	GetFilteredPurchaseOrders(req *contracts.GetFilteredPurchaseOrdersRequest) ([]*PurchaseOrder, error)
+	UpdatePurchaseOrderStatus(id string, status contracts.PurchaseOrderStatus) error
+	CreateStockLot(lot *StockLot, receipt *StockEntry) error
+	AddStockEntries(entries []*StockEntry) error
+	GetStockEntries(req *contracts.GetStockEntriesRequest) ([]*StockEntry, error)
+	GetStockLots(req *contracts.GetStockLotsRequest) ([]*StockLot, error)
+	GetStockLevels(flowerIDs []string) ([]*StockLevel, error)
+	CreateCustomer(customer *Customer) error
+	EditCustomer(customer *Customer) error
+	DeleteCustomer(id string) error
+	GetFilteredCustomers(req *contracts.GetFilteredCustomersRequest) ([]*Customer, error)
+	GetCustomer(id string) (*Customer, error)
+	GetCustomerEvents(customerID string) ([]*Event, error)
+	CreateQuote(quote *Quote) error
+	GetQuote(id string) (*Quote, error)
+	GetQuoteByVersion(eventID string, version int) (*Quote, error)
+	GetEventQuotes(eventID string) ([]*Quote, error)
+	UpdateQuoteStatus(id string, status contracts.QuoteStatus) error
+	// Search returns the flowers, products and events matching a full text query, deleted rows excluded
+	Search(req *contracts.SearchRequest) ([]*SearchHit, error)
+	CreateUser(user *User) error
+	GetUser(id string) (*User, error)
+	// GetUserByUsername returns nil when no user has the username
+	GetUserByUsername(username string) (*User, error)
+	GetUsers() ([]*User, error)
+	SetUserRole(id string, role contracts.Role) error
+	CreateAPIToken(token *APIToken) error
+	// GetAPITokenByHash returns nil when no token has the hash
+	GetAPITokenByHash(tokenHash string) (*APIToken, error)
+	GetUserAPITokens(userID string) ([]*APIToken, error)
+	RevokeAPIToken(id string) error
 }
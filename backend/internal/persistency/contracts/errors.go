@@ -0,0 +1,53 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The kinds of errors the persistency reports, match them with errors.Is
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("conflict")
+	ErrValidation          = errors.New("validation failed")
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+)
+
+// Error is an error of one of the kinds above
+type Error struct {
+	Kind    error
+	Message string
+	// Fields maps the offending fields to what is wrong with them, it is empty when no field is to blame
+	Fields map[string]string
+	// Err is the error of the database the error was made from, nil for errors found by the code
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+func NotFound(format string, args ...any) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func Conflict(format string, args ...any) error {
+	return &Error{Kind: ErrConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func Invalid(format string, args ...any) error {
+	return &Error{Kind: ErrValidation, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidField returns a validation error blaming one field
+func InvalidField(field string, format string, args ...any) error {
+	message := fmt.Sprintf(format, args...)
+	return &Error{Kind: ErrValidation, Message: message, Fields: map[string]string{field: message}}
+}
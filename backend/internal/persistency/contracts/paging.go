@@ -0,0 +1,161 @@
+package contracts
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"flower-management/contracts"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IDSortField ends every sort so rows with equal sort values still have a stable order
+const IDSortField = "id"
+
+// SortKind tells how the values of a sort field compare
+type SortKind int
+
+const (
+	SortText SortKind = iota
+	SortNumber
+	SortTime
+)
+
+// The fields the filtered lists can be sorted by, named after their columns
+var (
+	FlowerSortFields = map[string]SortKind{
+		"name":            SortText,
+		"shelf_life_days": SortNumber,
+	}
+	ProductSortFields = map[string]SortKind{
+		"name": SortText,
+	}
+	EventSortFields = map[string]SortKind{
+		"name":    SortText,
+		"date":    SortTime,
+		"status":  SortText,
+		"address": SortText,
+	}
+)
+
+// Page describes the rows returned for a PageRequest
+type Page struct {
+	// Total is the number of rows matching the filters over all the pages
+	Total int
+	// NextCursor selects the following page, it is empty on the last page
+	NextCursor string
+}
+
+// Sortable is a row of a paged list
+type Sortable interface {
+	// SortValue returns the value of a sort field as text
+	SortValue(field string) string
+}
+
+func (f *Flower) SortValue(field string) string {
+	switch field {
+	case "name":
+		return f.Name
+	case "shelf_life_days":
+		return strconv.Itoa(f.ShelfLifeDays)
+	}
+	return f.ID
+}
+
+func (p *Product) SortValue(field string) string {
+	if field == "name" {
+		return p.Name
+	}
+	return p.ID
+}
+
+func (e *Event) SortValue(field string) string {
+	switch field {
+	case "name":
+		return e.Name
+	case "date":
+		return e.Date.UTC().Format(time.RFC3339Nano)
+	case "status":
+		return string(e.Status)
+	case "address":
+		return e.Address
+	}
+	return e.ID
+}
+
+// SortWithID checks the sort fields against the allowed ones and appends the ID sort field.
+func SortWithID(sort []contracts.SortField, fields map[string]SortKind) ([]contracts.SortField, error) {
+	sortWithID := make([]contracts.SortField, 0, len(sort)+1)
+	for _, sortField := range sort {
+		if _, ok := fields[sortField.Field]; !ok {
+			return nil, InvalidField("sort", "cannot sort by %s", sortField.Field)
+		}
+		sortWithID = append(sortWithID, sortField)
+	}
+
+	return append(sortWithID, contracts.SortField{Field: IDSortField}), nil
+}
+
+// NewCursor returns the cursor of the page following row, sort must end with the ID sort field.
+func NewCursor(row Sortable, sort []contracts.SortField) string {
+	values := make([]string, len(sort))
+	for i, sortField := range sort {
+		values[i] = row.SortValue(sortField.Field)
+	}
+
+	encoded, _ := json.Marshal(values)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// DecodeCursor returns the sort values held by a cursor of the given sort.
+func DecodeCursor(cursor string, sort []contracts.SortField) ([]string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, InvalidField("cursor", "invalid cursor: %v", err)
+	}
+
+	var values []string
+	if err := json.Unmarshal(decoded, &values); err != nil {
+		return nil, InvalidField("cursor", "invalid cursor: %v", err)
+	}
+	if len(values) != len(sort) {
+		return nil, InvalidField("cursor", "invalid cursor: it does not match the sort")
+	}
+
+	return values, nil
+}
+
+// ParseSortValue converts a sort value back to the type of its field.
+func ParseSortValue(kind SortKind, value string) (interface{}, error) {
+	switch kind {
+	case SortNumber:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, InvalidField("cursor", "invalid cursor: %v", err)
+		}
+		return number, nil
+	case SortTime:
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, InvalidField("cursor", "invalid cursor: %v", err)
+		}
+		return parsed, nil
+	}
+	return value, nil
+}
+
+// CompareSortValues compares two values of a field, it returns -1, 0 or 1.
+func CompareSortValues(kind SortKind, a, b string) int {
+	switch kind {
+	case SortNumber:
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return cmp.Compare(x, y)
+	case SortTime:
+		x, _ := time.Parse(time.RFC3339Nano, a)
+		y, _ := time.Parse(time.RFC3339Nano, b)
+		return x.Compare(y)
+	}
+	return strings.Compare(a, b)
+}
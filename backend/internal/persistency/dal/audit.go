@@ -0,0 +1,186 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// anonymousActor is recorded for changes made without an actor
+const anonymousActor = "anonymous"
+
+// auditSnapshots select the state of an entity as JSON, together with the rows that belong to it. The
+// generated search vectors and the password and token hashes are left out. $2 is the tenant of the Dal, the
+// users and their tokens are also found without one like in the logins.
+var auditSnapshots = map[contracts.AuditEntityType]string{
+	contracts.AuditEntityFlower: `SELECT json_build_object(
+			'flower', to_jsonb(f) - 'search_vector',
+			'packing_options', COALESCE((SELECT json_agg(o ORDER BY o.supplier_id, o.num_of_flowers) FROM flower_package_options o WHERE o.flower_id = f.id AND o.tenant_id = f.tenant_id), '[]'::json))
+		FROM flowers f WHERE f.id = $1 AND f.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityProduct: `SELECT json_build_object(
+			'product', to_jsonb(p) - 'search_vector',
+			'flowers', COALESCE((SELECT json_agg(fp ORDER BY fp.flower_id) FROM flower_in_product fp WHERE fp.product_id = p.id AND fp.tenant_id = p.tenant_id), '[]'::json))
+		FROM products p WHERE p.id = $1 AND p.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityEvent: `SELECT json_build_object(
+			'event', to_jsonb(e) - 'search_vector',
+			'products', COALESCE((SELECT json_agg(ep ORDER BY ep.product_id) FROM event_product ep WHERE ep.event_id = e.id AND ep.tenant_id = e.tenant_id), '[]'::json))
+		FROM events e WHERE e.id = $1 AND e.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntitySupplier: `SELECT row_to_json(s) FROM suppliers s WHERE s.id = $1 AND s.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityCustomer: `SELECT row_to_json(c) FROM customers c WHERE c.id = $1 AND c.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityPurchaseOrder: `SELECT json_build_object(
+			'purchase_order', row_to_json(po),
+			'lines', COALESCE((SELECT json_agg(l ORDER BY l.flower_id, l.num_of_flowers_in_package) FROM purchase_order_lines l WHERE l.purchase_order_id = po.id AND l.tenant_id = po.tenant_id), '[]'::json))
+		FROM purchase_orders po WHERE po.id = $1 AND po.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityStockLot: `SELECT json_build_object(
+			'stock_lot', row_to_json(l),
+			'remaining', (SELECT COALESCE(SUM(s.quantity), 0) FROM stock_ledger s WHERE s.lot_id = l.id AND s.tenant_id = l.tenant_id))
+		FROM stock_lots l WHERE l.id = $1 AND l.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityQuote: `SELECT json_build_object(
+			'quote', row_to_json(q),
+			'line_items', COALESCE((SELECT json_agg(li ORDER BY li.product_id) FROM quote_line_items li WHERE li.quote_id = q.id AND li.tenant_id = q.tenant_id), '[]'::json))
+		FROM quotes q WHERE q.id = $1 AND q.tenant_id = NULLIF($2, '')::uuid`,
+	contracts.AuditEntityUser: `SELECT to_jsonb(u) - 'password_hash' FROM users u
+		WHERE u.id = $1 AND (NULLIF($2, '')::uuid IS NULL OR u.tenant_id = NULLIF($2, '')::uuid)`,
+	contracts.AuditEntityAPIToken: `SELECT to_jsonb(t) - 'token_hash' FROM api_tokens t JOIN users u ON u.id = t.user_id
+		WHERE t.id = $1 AND (NULLIF($2, '')::uuid IS NULL OR u.tenant_id = NULLIF($2, '')::uuid)`,
+}
+
+// WithActor returns a Dal sharing the same pool and tenant that records its changes as made by actor.
+func (d *Dal) WithActor(actor string) persistency.DalInterface {
+	return &Dal{
+		pool:  d.pool,
+		actor: actor,
+	}
+}
+
+// inAuditedTransaction runs mutate in a transaction and records the change it made to one entity in the
+// audit log of the same transaction, so a change is never stored without its record.
+func (d *Dal) inAuditedTransaction(entityType contracts.AuditEntityType, entityID string, action contracts.AuditAction, mutate func(ctx context.Context, tx pgx.Tx) error) error {
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	before, err := d.snapshotEntity(ctx, tx, entityType, entityID)
+	if err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if err := mutate(ctx, tx); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if err := d.writeAudit(ctx, tx, entityType, entityID, action, before); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// snapshotEntity returns the entity as JSON, or nil when it does not exist
+func (d *Dal) snapshotEntity(ctx context.Context, tx pgx.Tx, entityType contracts.AuditEntityType, entityID string) ([]byte, error) {
+	var snapshot []byte
+	err := tx.QueryRow(ctx, auditSnapshots[entityType], entityID, d.pool.tenantID).Scan(&snapshot)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to snapshot %s: %w", entityType, err)
+	}
+
+	return snapshot, nil
+}
+
+// writeAudit records a change, the state after the change is read within the transaction
+func (d *Dal) writeAudit(ctx context.Context, tx pgx.Tx, entityType contracts.AuditEntityType, entityID string, action contracts.AuditAction, before []byte) error {
+	after, err := d.snapshotEntity(ctx, tx, entityType, entityID)
+	if err != nil {
+		return err
+	}
+
+	actor := d.actor
+	if actor == "" {
+		actor = anonymousActor
+	}
+
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", uuid.New().String())
+	parameterEnumerator.AppendParameter("tenant_id", d.pool.tenantID)
+	parameterEnumerator.AppendParameter("actor", actor)
+	parameterEnumerator.AppendParameter("created_at", time.Now().UTC())
+	parameterEnumerator.AppendParameter("entity_type", entityType)
+	parameterEnumerator.AppendParameter("entity_id", entityID)
+	parameterEnumerator.AppendParameter("action", action)
+	parameterEnumerator.AppendParameter("before", before)
+	parameterEnumerator.AppendParameter("after", after)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO audit_log (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query within the transaction
+	_, err = tx.Exec(ctx, query, queryEnumerator.args...)
+	if err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Dal) GetAuditRecords(req *contracts.GetAuditRecordsRequest) ([]*persistency.AuditRecord, error) {
+	query := `SELECT id, tenant_id, actor, created_at, entity_type, entity_id, action, before, after FROM audit_log WHERE 1=1`
+	enumerator := &parameterEnumerate{}
+	query += enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+
+	if req.EntityType != "" {
+		query += enumerator.CreateExactCondition("entity_type", req.EntityType)
+	}
+	if req.EntityID != "" {
+		query += enumerator.CreateExactCondition("entity_id", req.EntityID)
+	}
+	query += " ORDER BY created_at, id"
+
+	// Prepare the query with parameters
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*persistency.AuditRecord
+
+	// Scan the results into a slice of AuditRecord
+	for rows.Next() {
+		var record persistency.AuditRecord
+		var before, after []byte
+		if err := rows.Scan(&record.ID, &record.TenantID, &record.Actor, &record.CreatedAt, &record.EntityType, &record.EntityID, &record.Action, &before, &after); err != nil {
+			return nil, fmt.Errorf("failed to scan audit record: %w", err)
+		}
+		record.Before = before
+		record.After = after
+		records = append(records, &record)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over audit records: %w", err)
+	}
+
+	return records, nil
+}
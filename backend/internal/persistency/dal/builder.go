@@ -1,8 +1,10 @@
 package dal
 
 import (
+	"flower-management/contracts"
 	"fmt"
 	"strings"
+	"time"
 )
 
 type queryParameterEnumerate struct {
@@ -38,6 +40,71 @@ func (enumerator *parameterEnumerate) CreateExactCondition(columnName string, va
 	return fmt.Sprintf(" AND %s = $%d", columnName, enumerator.index)
 }
 
+// CreateTextCondition compares a text column in the given mode ignoring case, an empty value adds no condition.
+func (enumerator *parameterEnumerate) CreateTextCondition(columnName, value string, mode contracts.MatchMode) string {
+	if value == "" {
+		return ""
+	}
+
+	switch mode {
+	case contracts.MatchExact:
+		return fmt.Sprintf(" AND LOWER(%s) = LOWER(%s)", columnName, enumerator.Enumerate(value))
+	case contracts.MatchPrefix:
+		return fmt.Sprintf(" AND %s ILIKE %s", columnName, enumerator.Enumerate(escapeLike(value)+"%"))
+	}
+	return fmt.Sprintf(" AND %s ILIKE %s", columnName, enumerator.Enumerate("%"+escapeLike(value)+"%"))
+}
+
+// CreateAnyCondition matches a column against any of the values, no values add no condition.
+func (enumerator *parameterEnumerate) CreateAnyCondition(columnName string, values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" AND %s = ANY(%s)", columnName, enumerator.Enumerate(values))
+}
+
+// CreateRangeCondition compares a column with a bound using operator, a zero bound adds no condition.
+func (enumerator *parameterEnumerate) CreateRangeCondition(columnName, operator string, bound time.Time) string {
+	if bound.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf(" AND %s %s %s", columnName, operator, enumerator.Enumerate(bound))
+}
+
+// escapeLike makes the LIKE wildcards of value match literally
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// CreateKeysetCondition selects the rows that follow the row holding values in the given sort. The
+// fields compare one after the other so each of them can have its own direction.
+func (enumerator *parameterEnumerate) CreateKeysetCondition(sort []contracts.SortField, values []interface{}) string {
+	parameters := make([]string, len(values))
+	for i, value := range values {
+		parameters[i] = enumerator.Enumerate(value)
+	}
+
+	alternatives := make([]string, len(sort))
+	for i, sortField := range sort {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, fmt.Sprintf("%s = %s", sort[j].Field, parameters[j]))
+		}
+		operator := ">"
+		if sortField.Descending {
+			operator = "<"
+		}
+		terms = append(terms, fmt.Sprintf("%s %s %s", sortField.Field, operator, parameters[i]))
+		alternatives[i] = "(" + strings.Join(terms, " AND ") + ")"
+	}
+
+	return fmt.Sprintf(" AND (%s)", strings.Join(alternatives, " OR "))
+}
+
 func (enumerator *queryParameterEnumerate) AppendParameter(column string, value interface{}) {
 	enumerator.columns = append(enumerator.columns, column)
 	parameter := enumerator.enumerator.Enumerate(value)
@@ -60,3 +127,17 @@ func (enumerator *queryParameterEnumerate) GetAssignedParameters() string {
 
 	return strings.Join(assignParameters, ", ")
 }
+
+// CreateTenantCondition keeps the rows of the tenant only. The row level security policies of the changelog
+// do the same, the condition keeps a query isolated even when it runs as a role that bypasses them. Without
+// a tenant no row matches, like under the policies.
+func (enumerator *parameterEnumerate) CreateTenantCondition(columnName, tenantID string) string {
+	return fmt.Sprintf(" AND %s = NULLIF(%s, '')::uuid", columnName, enumerator.Enumerate(tenantID))
+}
+
+// CreateLoginTenantCondition keeps the users of the tenant only, like the users policy of the changelog.
+// Without a tenant every user matches, the logins look users up before their tenant is known.
+func (enumerator *parameterEnumerate) CreateLoginTenantCondition(columnName, tenantID string) string {
+	parameter := enumerator.Enumerate(tenantID)
+	return fmt.Sprintf(" AND (NULLIF(%[1]s, '')::uuid IS NULL OR %[2]s = NULLIF(%[1]s, '')::uuid)", parameter, columnName)
+}
@@ -0,0 +1,166 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (d *Dal) CreateCustomer(customer *persistency.Customer) error {
+	customer.ID = uuid.New().String()
+	customer.TenantID = d.pool.tenantID
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", customer.ID)
+	parameterEnumerator.AppendParameter("tenant_id", customer.TenantID)
+	parameterEnumerator.AppendParameter("name", customer.Name)
+	parameterEnumerator.AppendParameter("phone", customer.Phone)
+	parameterEnumerator.AppendParameter("email", customer.Email)
+	parameterEnumerator.AppendParameter("address", customer.Address)
+	parameterEnumerator.AppendParameter("notes", customer.Notes)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO customers (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityCustomer, customer.ID, contracts.AuditActionCreate, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to create customer: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) EditCustomer(customer *persistency.Customer) error {
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	customerIDParameter := queryEnumerator.Enumerate(customer.ID)
+
+	// Append parameters to the enumerator
+	parameterEnumerator.AppendParameter("name", customer.Name)
+	parameterEnumerator.AppendParameter("phone", customer.Phone)
+	parameterEnumerator.AppendParameter("email", customer.Email)
+	parameterEnumerator.AppendParameter("address", customer.Address)
+	parameterEnumerator.AppendParameter("notes", customer.Notes)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"UPDATE customers SET %s WHERE id = %s%s",
+		parameterEnumerator.GetAssignedParameters(),
+		customerIDParameter,
+		queryEnumerator.CreateTenantCondition("tenant_id", d.pool.tenantID))
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityCustomer, customer.ID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to edit customer: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("customer with ID %s does not exist", customer.ID)
+		}
+
+		return nil
+	})
+}
+
+// DeleteCustomer deletes a customer, its events keep their inline contact details.
+func (d *Dal) DeleteCustomer(id string) error {
+	query := "DELETE FROM customers WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityCustomer, id, contracts.AuditActionDelete, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to delete customer: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("customer with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) GetFilteredCustomers(req *contracts.GetFilteredCustomersRequest) ([]*persistency.Customer, error) {
+	query := "SELECT id, tenant_id, name, phone, email, address, notes FROM customers WHERE 1=1"
+	enumerator := &parameterEnumerate{}
+	query += enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+
+	if req.Search != "" {
+		searchParameter := enumerator.Enumerate("%" + req.Search + "%")
+		query += fmt.Sprintf(" AND (name ILIKE %[1]s OR phone ILIKE %[1]s OR email ILIKE %[1]s)", searchParameter)
+	}
+	query += " ORDER BY name, id"
+
+	// Prepare the query with parameters
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filtered customers: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []*persistency.Customer
+
+	// Scan the results into a slice of Customer
+	for rows.Next() {
+		var customer persistency.Customer
+		if err := rows.Scan(&customer.ID, &customer.TenantID, &customer.Name, &customer.Phone, &customer.Email, &customer.Address, &customer.Notes); err != nil {
+			return nil, fmt.Errorf("failed to scan customer: %w", err)
+		}
+		customers = append(customers, &customer)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over customers: %w", err)
+	}
+
+	return customers, nil
+}
+
+func (d *Dal) GetCustomer(id string) (*persistency.Customer, error) {
+	query := "SELECT id, tenant_id, name, phone, email, address, notes FROM customers WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid"
+
+	// Execute the query
+	row := d.pool.QueryRow(context.Background(), query, id, d.pool.tenantID)
+
+	// Create a Customer instance to hold the result
+	var customer persistency.Customer
+
+	// Scan the result into the customer instance
+	err := row.Scan(&customer.ID, &customer.TenantID, &customer.Name, &customer.Phone, &customer.Email, &customer.Address, &customer.Notes)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("customer with ID %s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return &customer, nil
+}
+
+// GetCustomerEvents returns the events of a customer, the latest first.
+func (d *Dal) GetCustomerEvents(customerID string) ([]*persistency.Event, error) {
+	query := fmt.Sprintf("SELECT %s FROM events WHERE customer_id = $1 AND tenant_id = NULLIF($2, '')::uuid AND deleted_at IS NULL ORDER BY date DESC, id", eventColumns)
+
+	rows, err := d.pool.Query(context.Background(), query, customerID, d.pool.tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer events: %w", err)
+	}
+	defer rows.Close()
+
+	return scanEvents(rows)
+}
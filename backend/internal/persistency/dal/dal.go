@@ -6,6 +6,7 @@ import (
 	"flower-management/internal/core/config"
 	persistency "flower-management/internal/persistency/contracts"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -13,7 +14,8 @@ import (
 )
 
 type Dal struct {
-	pool *pgxpool.Pool
+	pool  *tenantPool
+	actor string
 }
 
 func NewDal(ctx context.Context, dalConfig *config.DalConfig) (persistency.DalInterface, error) {
@@ -29,10 +31,16 @@ func NewDal(ctx context.Context, dalConfig *config.DalConfig) (persistency.DalIn
 	}
 
 	return &Dal{
-		pool: pool,
+		pool: &tenantPool{pool: pool},
 	}, nil
 }
 
+// Close waits for the queries in flight and closes the pool, the Dals made by WithActor and WithTenant
+// share the pool and cannot be used afterwards either
+func (d *Dal) Close() {
+	d.pool.pool.Close()
+}
+
 func (d *Dal) CreateFlower(flower *persistency.Flower, packingOptions *[]contracts.PackingOptions) error {
 	tx, err := d.pool.Begin(context.Background())
 	if err != nil {
@@ -40,9 +48,12 @@ func (d *Dal) CreateFlower(flower *persistency.Flower, packingOptions *[]contrac
 	}
 
 	flower.ID = uuid.New().String()
+	flower.TenantID = d.pool.tenantID
 	flowerQueryEnumerator, flowerParameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
 	flowerParameterEnumerator.AppendParameter("id", flower.ID)
+	flowerParameterEnumerator.AppendParameter("tenant_id", flower.TenantID)
 	flowerParameterEnumerator.AppendParameter("name", flower.Name)
+	flowerParameterEnumerator.AppendParameter("shelf_life_days", flower.ShelfLifeDays)
 
 	// Construct the SQL query
 	query := fmt.Sprintf(
@@ -59,8 +70,15 @@ func (d *Dal) CreateFlower(flower *persistency.Flower, packingOptions *[]contrac
 	}
 
 	for _, packingOption := range *packingOptions {
+		supplierID := packingOption.SupplierID
+		if supplierID == "" {
+			supplierID = persistency.DefaultSupplierID
+		}
+
 		packingOptionQueryEnumerator, packingOptionParameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
 		packingOptionParameterEnumerator.AppendParameter("flower_id", flower.ID)
+		packingOptionParameterEnumerator.AppendParameter("tenant_id", flower.TenantID)
+		packingOptionParameterEnumerator.AppendParameter("supplier_id", supplierID)
 		packingOptionParameterEnumerator.AppendParameter("num_of_flowers", packingOption.Quantity)
 		packingOptionParameterEnumerator.AppendParameter("price", packingOption.Price)
 
@@ -79,6 +97,12 @@ func (d *Dal) CreateFlower(flower *persistency.Flower, packingOptions *[]contrac
 		}
 	}
 
+	err = d.writeAudit(context.Background(), tx, contracts.AuditEntityFlower, flower.ID, contracts.AuditActionCreate, nil)
+	if err != nil {
+		tx.Rollback(context.Background())
+		return err
+	}
+
 	err = tx.Commit(context.Background())
 	if err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
@@ -89,8 +113,10 @@ func (d *Dal) CreateFlower(flower *persistency.Flower, packingOptions *[]contrac
 
 func (d *Dal) CreateProduct(product *persistency.Product) error {
 	product.ID = uuid.New().String()
+	product.TenantID = d.pool.tenantID
 	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
 	parameterEnumerator.AppendParameter("id", product.ID)
+	parameterEnumerator.AppendParameter("tenant_id", product.TenantID)
 	parameterEnumerator.AppendParameter("name", product.Name)
 	parameterEnumerator.AppendParameter("description", product.Description)
 
@@ -101,21 +127,27 @@ func (d *Dal) CreateProduct(product *persistency.Product) error {
 		parameterEnumerator.GetParameters(),
 	)
 
-	// Execute the query
-	_, err := d.pool.Exec(context.Background(), query, queryEnumerator.args...)
-	if err != nil {
-		return fmt.Errorf("failed to create product: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityProduct, product.ID, contracts.AuditActionCreate, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to create product: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) CreateEvent(event *persistency.Event) error {
 	event.ID = uuid.New().String()
+	event.TenantID = d.pool.tenantID
 	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
 	parameterEnumerator.AppendParameter("id", event.ID)
+	parameterEnumerator.AppendParameter("tenant_id", event.TenantID)
 	parameterEnumerator.AppendParameter("name", event.Name)
 	parameterEnumerator.AppendParameter("date", event.Date)
+	parameterEnumerator.AppendParameter("status", event.Status)
+	parameterEnumerator.AppendParameter("customer_id", nullableString(event.CustomerID))
 	parameterEnumerator.AppendParameter("phone", event.Phone)
 	parameterEnumerator.AppendParameter("email", event.Email)
 	parameterEnumerator.AppendParameter("address", event.Address)
@@ -128,13 +160,15 @@ func (d *Dal) CreateEvent(event *persistency.Event) error {
 		parameterEnumerator.GetParameters(),
 	)
 
-	// Execute the query
-	_, err := d.pool.Exec(context.Background(), query, queryEnumerator.args...)
-	if err != nil {
-		return fmt.Errorf("failed to create event: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, event.ID, contracts.AuditActionCreate, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to create event: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) EditFlower(flower *persistency.Flower) error {
@@ -143,25 +177,29 @@ func (d *Dal) EditFlower(flower *persistency.Flower) error {
 
 	// Append parameters to the enumerator
 	parameterEnumerator.AppendParameter("name", flower.Name)
+	parameterEnumerator.AppendParameter("shelf_life_days", flower.ShelfLifeDays)
 
 	// Construct the SQL query
 	query := fmt.Sprintf(
-		"UPDATE flowers SET %s WHERE id = %s",
+		"UPDATE flowers SET %s WHERE id = %s%s",
 		parameterEnumerator.GetAssignedParameters(),
-		flowerIDParameter)
+		flowerIDParameter,
+		queryEnumerator.CreateTenantCondition("tenant_id", d.pool.tenantID))
 
-	// Execute the query
-	result, err := d.pool.Exec(context.Background(), query, queryEnumerator.args...)
-	if err != nil {
-		return fmt.Errorf("failed to edit flower: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityFlower, flower.ID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to edit flower: %w", err)
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("flower with ID %s does not exist", flower.ID)
-	}
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("flower with ID %s does not exist", flower.ID)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) EditProduct(product *persistency.Product) error {
@@ -174,22 +212,25 @@ func (d *Dal) EditProduct(product *persistency.Product) error {
 
 	// Construct the SQL query
 	query := fmt.Sprintf(
-		"UPDATE products SET %s WHERE id = %s",
+		"UPDATE products SET %s WHERE id = %s%s",
 		parameterEnumerator.GetAssignedParameters(),
-		productIDParameter)
+		productIDParameter,
+		queryEnumerator.CreateTenantCondition("tenant_id", d.pool.tenantID))
 
-	// Execute the query
-	result, err := d.pool.Exec(context.Background(), query, queryEnumerator.args...)
-	if err != nil {
-		return fmt.Errorf("failed to edit product: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityProduct, product.ID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to edit product: %w", err)
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("product with ID %s does not exist", product.ID)
-	}
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("product with ID %s does not exist", product.ID)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) EditEvent(event *persistency.Event) error {
@@ -199,6 +240,7 @@ func (d *Dal) EditEvent(event *persistency.Event) error {
 	// Append parameters to the enumerator
 	parameterEnumerator.AppendParameter("name", event.Name)
 	parameterEnumerator.AppendParameter("date", event.Date)
+	parameterEnumerator.AppendParameter("customer_id", nullableString(event.CustomerID))
 	parameterEnumerator.AppendParameter("phone", event.Phone)
 	parameterEnumerator.AppendParameter("email", event.Email)
 	parameterEnumerator.AppendParameter("address", event.Address)
@@ -206,85 +248,185 @@ func (d *Dal) EditEvent(event *persistency.Event) error {
 
 	// Construct the SQL query
 	query := fmt.Sprintf(
-		"UPDATE events SET %s WHERE id = %s",
+		"UPDATE events SET %s WHERE id = %s%s",
 		parameterEnumerator.GetAssignedParameters(),
-		eventIDParameter)
+		eventIDParameter,
+		queryEnumerator.CreateTenantCondition("tenant_id", d.pool.tenantID))
 
-	// Execute the query
-	result, err := d.pool.Exec(context.Background(), query, queryEnumerator.args...)
-	if err != nil {
-		return fmt.Errorf("failed to edit event: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, event.ID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to edit event: %w", err)
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("event with ID %s does not exist", event.ID)
-	}
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("event with ID %s does not exist", event.ID)
+		}
 
-	return nil
+		return nil
+	})
+}
+
+func (d *Dal) UpdateEventStatus(id string, status contracts.EventStatus) error {
+	query := "UPDATE events SET status = $1 WHERE id = $2 AND tenant_id = NULLIF($3, '')::uuid"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, id, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, status, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to update event status: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("event with ID %s does not exist", id)
+		}
+
+		return nil
+	})
 }
 
 func (d *Dal) DeleteFlower(id string) error {
-	query := "DELETE FROM flowers WHERE id = $1"
+	query := "UPDATE flowers SET deleted_at = $1 WHERE id = $2 AND tenant_id = NULLIF($3, '')::uuid AND deleted_at IS NULL"
 
-	// Execute the query
-	result, err := d.pool.Exec(context.Background(), query, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete flower: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityFlower, id, contracts.AuditActionDelete, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, time.Now().UTC(), id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to delete flower: %w", err)
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("flower with ID %s does not exist", id)
-	}
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("flower with ID %s does not exist", id)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) DeleteProduct(id string) error {
-	query := "DELETE FROM products WHERE id = $1"
+	query := "UPDATE products SET deleted_at = $1 WHERE id = $2 AND tenant_id = NULLIF($3, '')::uuid AND deleted_at IS NULL"
 
-	// Execute the query
-	result, err := d.pool.Exec(context.Background(), query, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete product: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityProduct, id, contracts.AuditActionDelete, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, time.Now().UTC(), id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to delete product: %w", err)
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("product with ID %s does not exist", id)
-	}
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("product with ID %s does not exist", id)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) DeleteEvent(id string) error {
-	query := "DELETE FROM events WHERE id = $1"
+	query := "UPDATE events SET deleted_at = $1 WHERE id = $2 AND tenant_id = NULLIF($3, '')::uuid AND deleted_at IS NULL"
 
-	// Execute the query
-	result, err := d.pool.Exec(context.Background(), query, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete event: %w", err)
-	}
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, id, contracts.AuditActionDelete, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, time.Now().UTC(), id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to delete event: %w", err)
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("event with ID %s does not exist", id)
-	}
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("event with ID %s does not exist", id)
+		}
 
-	return nil
+		return nil
+	})
+}
+
+func (d *Dal) RestoreFlower(id string) error {
+	query := "UPDATE flowers SET deleted_at = NULL WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid AND deleted_at IS NOT NULL"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityFlower, id, contracts.AuditActionRestore, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to restore flower: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("deleted flower with ID %s does not exist", id)
+		}
+
+		return nil
+	})
 }
 
-func (d *Dal) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, error) {
-	query := "SELECT id, name, num_of_flowers_in_package FROM flowers WHERE 1=1"
+func (d *Dal) RestoreProduct(id string) error {
+	query := "UPDATE products SET deleted_at = NULL WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid AND deleted_at IS NOT NULL"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityProduct, id, contracts.AuditActionRestore, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to restore product: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("deleted product with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) RestoreEvent(id string) error {
+	query := "UPDATE events SET deleted_at = NULL WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid AND deleted_at IS NOT NULL"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, id, contracts.AuditActionRestore, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to restore event: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("deleted event with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, *persistency.Page, error) {
 	enumerator := &parameterEnumerate{}
+	conditions := enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+
+	conditions += enumerator.CreateAnyCondition("id", req.IDs)
+	conditions += enumerator.CreateTextCondition("name", req.Name, req.Match)
+	if !req.IncludeDeleted {
+		conditions += " AND deleted_at IS NULL"
+	}
 
-	query += enumerator.CreateLikeCondition("name", req.Name)
+	total, err := d.countRows("flowers", conditions, enumerator.args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paging, sort, err := pageClause(enumerator, &req.Page, persistency.FlowerSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := "SELECT id, tenant_id, name, shelf_life_days, deleted_at FROM flowers WHERE 1=1" + conditions + paging
 
 	// Prepare the query with parameters
 	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get filtered flowers: %w", err)
+		return nil, nil, fmt.Errorf("failed to get filtered flowers: %w", err)
 	}
 	defer rows.Close()
 
@@ -293,32 +435,47 @@ func (d *Dal) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*p
 	// Scan the results into a slice of Flower
 	for rows.Next() {
 		var flower persistency.Flower
-		if err := rows.Scan(&flower.ID, &flower.Name); err != nil {
-			return nil, fmt.Errorf("failed to scan flower: %w", err)
+		if err := rows.Scan(&flower.ID, &flower.TenantID, &flower.Name, &flower.ShelfLifeDays, &flower.DeletedAt); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan flower: %w", err)
 		}
 		flowers = append(flowers, &flower)
 	}
 
 	// Check for errors from iterating over rows
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error occurred while iterating over flowers: %w", err)
+		return nil, nil, fmt.Errorf("error occurred while iterating over flowers: %w", err)
 	}
 
-	return flowers, nil
-
+	flowers, page := nextPage(flowers, req.Page.Limit, sort, total)
+	return flowers, page, nil
 }
 
-func (d *Dal) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, error) {
-	query := "SELECT id, name, description FROM products WHERE 1=1"
+func (d *Dal) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, *persistency.Page, error) {
 	enumerator := &parameterEnumerate{}
+	conditions := enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
 
-	query += enumerator.CreateLikeCondition("name", req.Name)
-	query += enumerator.CreateLikeCondition("description", req.Description)
+	conditions += enumerator.CreateAnyCondition("id", req.IDs)
+	conditions += enumerator.CreateTextCondition("name", req.Name, req.Match)
+	conditions += enumerator.CreateTextCondition("description", req.Description, req.Match)
+	if !req.IncludeDeleted {
+		conditions += " AND deleted_at IS NULL"
+	}
+
+	total, err := d.countRows("products", conditions, enumerator.args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paging, sort, err := pageClause(enumerator, &req.Page, persistency.ProductSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := "SELECT id, tenant_id, name, description, deleted_at FROM products WHERE 1=1" + conditions + paging
 
 	// Prepare the query with parameters
 	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get filtered products: %w", err)
+		return nil, nil, fmt.Errorf("failed to get filtered products: %w", err)
 	}
 	defer rows.Close()
 
@@ -327,69 +484,88 @@ func (d *Dal) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]
 	// Scan the results into a slice of Product
 	for rows.Next() {
 		var product persistency.Product
-		if err := rows.Scan(&product.ID, &product.Name, &product.Description); err != nil {
-			return nil, fmt.Errorf("failed to scan product: %w", err)
+		if err := rows.Scan(&product.ID, &product.TenantID, &product.Name, &product.Description, &product.DeletedAt); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan product: %w", err)
 		}
 		products = append(products, &product)
 	}
 
 	// Check for errors from iterating over rows
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error occurred while iterating over products: %w", err)
+		return nil, nil, fmt.Errorf("error occurred while iterating over products: %w", err)
 	}
 
-	return products, nil
+	products, page := nextPage(products, req.Page.Limit, sort, total)
+	return products, page, nil
 }
 
-func (d *Dal) GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*persistency.Event, error) {
-	query := "SELECT id, name, date, phone, email, address, description FROM events WHERE 1=1"
+func (d *Dal) GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*persistency.Event, *persistency.Page, error) {
 	enumerator := &parameterEnumerate{}
+	conditions := enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+
+	conditions += enumerator.CreateAnyCondition("id", req.IDs)
+	conditions += enumerator.CreateTextCondition("name", req.Name, req.Match)
+	conditions += enumerator.CreateTextCondition("address", req.Address, req.Match)
+	conditions += enumerator.CreateTextCondition("description", req.Description, req.Match)
+	if !req.Date.IsZero() {
+		conditions += enumerator.CreateRangeCondition("date", ">=", req.Date)
+		conditions += enumerator.CreateRangeCondition("date", "<", req.Date.AddDate(0, 0, 1))
+	}
+	conditions += enumerator.CreateRangeCondition("date", ">=", req.DateFrom)
+	conditions += enumerator.CreateRangeCondition("date", "<", req.DateTo)
+	conditions += enumerator.CreateAnyCondition("customer_id", req.CustomerIDs)
+	if len(req.Statuses) > 0 {
+		statuses := make([]string, len(req.Statuses))
+		for i, status := range req.Statuses {
+			statuses[i] = string(status)
+		}
+		conditions += enumerator.CreateAnyCondition("status", statuses)
+	}
+	if !req.IncludeDeleted {
+		conditions += " AND deleted_at IS NULL"
+	}
 
-	query += enumerator.CreateLikeCondition("name", req.Name)
-	query += enumerator.CreateExactCondition("date", req.Date)
-	query += enumerator.CreateLikeCondition("address", req.Address)
-	query += enumerator.CreateLikeCondition("description", req.Description)
+	total, err := d.countRows("events", conditions, enumerator.args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paging, sort, err := pageClause(enumerator, &req.Page, persistency.EventSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := fmt.Sprintf("SELECT %s FROM events WHERE 1=1", eventColumns) + conditions + paging
 
 	// Prepare the query with parameters
 	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get filtered events: %w", err)
+		return nil, nil, fmt.Errorf("failed to get filtered events: %w", err)
 	}
 	defer rows.Close()
 
-	var events []*persistency.Event
-
-	// Scan the results into a slice of Event
-	for rows.Next() {
-		var event persistency.Event
-		if err := rows.Scan(&event.ID, &event.Name, &event.Date, &event.Phone, &event.Email, &event.Address, &event.Description); err != nil {
-			return nil, fmt.Errorf("failed to scan event: %w", err)
-		}
-		events = append(events, &event)
-	}
-
-	// Check for errors from iterating over rows
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error occurred while iterating over events: %w", err)
+	events, err := scanEvents(rows)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return events, nil
+	events, page := nextPage(events, req.Page.Limit, sort, total)
+	return events, page, nil
 }
 
 func (d *Dal) GetFlower(id string) (*persistency.Flower, error) {
-	query := "SELECT id, name FROM flowers WHERE id = $1"
+	query := "SELECT id, tenant_id, name, shelf_life_days, deleted_at FROM flowers WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid"
 
 	// Execute the query
-	row := d.pool.QueryRow(context.Background(), query, id)
+	row := d.pool.QueryRow(context.Background(), query, id, d.pool.tenantID)
 
 	// Create a Flower instance to hold the result
 	var flower persistency.Flower
 
 	// Scan the result into the flower instance
-	err := row.Scan(&flower.ID, &flower.Name)
+	err := row.Scan(&flower.ID, &flower.TenantID, &flower.Name, &flower.ShelfLifeDays, &flower.DeletedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("flower with ID %s does not exist", id)
+			return nil, persistency.NotFound("flower with ID %s does not exist", id)
 		}
 		return nil, fmt.Errorf("failed to get flower: %w", err)
 	}
@@ -398,40 +574,37 @@ func (d *Dal) GetFlower(id string) (*persistency.Flower, error) {
 }
 
 func (d *Dal) GetEvent(id string) (*persistency.Event, error) {
-	query := "SELECT id, name, date, phone, email, address, description FROM events WHERE id = $1"
+	query := fmt.Sprintf("SELECT %s FROM events WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid", eventColumns)
 
 	// Execute the query
-	row := d.pool.QueryRow(context.Background(), query, id)
-
-	// Create an Event instance to hold the result
-	var event persistency.Event
+	row := d.pool.QueryRow(context.Background(), query, id, d.pool.tenantID)
 
-	// Scan the result into the event instance
-	err := row.Scan(&event.ID, &event.Name, &event.Date, &event.Phone, &event.Email, &event.Address, &event.Description)
+	// Scan the result into an event instance
+	event, err := scanEvent(row)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("event with ID %s does not exist", id)
+			return nil, persistency.NotFound("event with ID %s does not exist", id)
 		}
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	return &event, nil
+	return event, nil
 }
 
 func (d *Dal) GetProduct(id string) (*persistency.Product, error) {
-	query := "SELECT id, name, description FROM products WHERE id = $1"
+	query := "SELECT id, tenant_id, name, description, deleted_at FROM products WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid"
 
 	// Execute the query
-	row := d.pool.QueryRow(context.Background(), query, id)
+	row := d.pool.QueryRow(context.Background(), query, id, d.pool.tenantID)
 
 	// Create a Product instance to hold the result
 	var product persistency.Product
 
 	// Scan the result into the product instance
-	err := row.Scan(&product.ID, &product.Name, &product.Description)
+	err := row.Scan(&product.ID, &product.TenantID, &product.Name, &product.Description, &product.DeletedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("product with ID %s does not exist", id)
+			return nil, persistency.NotFound("product with ID %s does not exist", id)
 		}
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
@@ -440,152 +613,117 @@ func (d *Dal) GetProduct(id string) (*persistency.Product, error) {
 }
 
 func (d *Dal) AddFlowersToProduct(req *contracts.AddFlowersToProductRequest) error {
-	ctx := context.Background()
-	tx, err := d.pool.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-
-	for _, flower := range *req.Flowers {
-		queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
-		parameterEnumerator.AppendParameter("product_id", req.ProductID)
-		parameterEnumerator.AppendParameter("flower_id", flower.FlowerID)
-		parameterEnumerator.AppendParameter("num_of_flowers", flower.NumOfFlowers)
-
-		// Construct the SQL query
-		query := fmt.Sprintf(
-			"INSERT INTO flower_in_product (%s) VALUES (%s)",
-			parameterEnumerator.GetColumns(),
-			parameterEnumerator.GetParameters(),
-		)
-
-		// Execute the query within the transaction
-		_, err = tx.Exec(context.Background(), query, queryEnumerator.args...)
-		if err != nil {
-			tx.Rollback(ctx)
-			return fmt.Errorf("failed to add flower to product: %w", err)
+	// Execute the queries and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityProduct, req.ProductID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		for _, flower := range *req.Flowers {
+			queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+			parameterEnumerator.AppendParameter("tenant_id", d.pool.tenantID)
+			parameterEnumerator.AppendParameter("product_id", req.ProductID)
+			parameterEnumerator.AppendParameter("flower_id", flower.FlowerID)
+			parameterEnumerator.AppendParameter("num_of_flowers", flower.NumOfFlowers)
+
+			// Construct the SQL query
+			query := fmt.Sprintf(
+				"INSERT INTO flower_in_product (%s) VALUES (%s)",
+				parameterEnumerator.GetColumns(),
+				parameterEnumerator.GetParameters(),
+			)
+
+			// Execute the query within the transaction
+			_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+			if err != nil {
+				return fmt.Errorf("failed to add flower to product: %w", err)
+			}
 		}
-	}
-
-	err = tx.Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
 
+		return nil
+	})
 }
 
 func (d *Dal) AddProductsToEvent(req *contracts.AddProductsToEventRequest) error {
-	ctx := context.Background()
-	tx, err := d.pool.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-
-	for _, product := range *req.Products {
-		queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
-		parameterEnumerator.AppendParameter("event_id", req.EventID)
-		parameterEnumerator.AppendParameter("product_id", product.ProductID)
-		parameterEnumerator.AppendParameter("quantity", product.Quantity)
-
-		// Construct the SQL query
-		query := fmt.Sprintf(
-			"INSERT INTO event_product (%s) VALUES (%s)",
-			parameterEnumerator.GetColumns(),
-			parameterEnumerator.GetParameters(),
-		)
-
-		// Execute the query within the transaction
-		_, err = tx.Exec(context.Background(), query, queryEnumerator.args...)
-		if err != nil {
-			tx.Rollback(ctx)
-			return fmt.Errorf("failed to add product to event: %w", err)
+	// Execute the queries and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, req.EventID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		for _, product := range *req.Products {
+			queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+			parameterEnumerator.AppendParameter("tenant_id", d.pool.tenantID)
+			parameterEnumerator.AppendParameter("event_id", req.EventID)
+			parameterEnumerator.AppendParameter("product_id", product.ProductID)
+			parameterEnumerator.AppendParameter("quantity", product.Quantity)
+
+			// Construct the SQL query
+			query := fmt.Sprintf(
+				"INSERT INTO event_product (%s) VALUES (%s)",
+				parameterEnumerator.GetColumns(),
+				parameterEnumerator.GetParameters(),
+			)
+
+			// Execute the query within the transaction
+			_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+			if err != nil {
+				return fmt.Errorf("failed to add product to event: %w", err)
+			}
 		}
-	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) EditFlowersInProduct(req *contracts.AddFlowersToProductRequest) error {
-	ctx := context.Background()
-	tx, err := d.pool.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-
-	for _, flower := range *req.Flowers {
-		queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
-		parameterEnumerator.AppendParameter("product_id", req.ProductID)
-		parameterEnumerator.AppendParameter("flower_id", flower.FlowerID)
-		parameterEnumerator.AppendParameter("num_of_flowers", flower.NumOfFlowers)
-
-		// Construct the SQL query
-		query := fmt.Sprintf(
-			"UPDATE flowers_in_products SET num_of_flowers = %s WHERE product_id = %s AND flower_id = %s",
-			parameterEnumerator.GetParameters(),
-			queryEnumerator.Enumerate(req.ProductID),
-			queryEnumerator.Enumerate(flower.FlowerID),
-		)
-
-		// Execute the query within the transaction
-		_, err = tx.Exec(context.Background(), query, queryEnumerator.args...)
-		if err != nil {
-			tx.Rollback(ctx)
-			return fmt.Errorf("failed to edit flower in product: %w", err)
+	// Execute the queries and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityProduct, req.ProductID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		for _, flower := range *req.Flowers {
+			enumerator := &parameterEnumerate{}
+
+			// Construct the SQL query
+			query := fmt.Sprintf(
+				"UPDATE flower_in_product SET num_of_flowers = %s WHERE product_id = %s AND flower_id = %s%s",
+				enumerator.Enumerate(flower.NumOfFlowers),
+				enumerator.Enumerate(req.ProductID),
+				enumerator.Enumerate(flower.FlowerID),
+				enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID),
+			)
+
+			// Execute the query within the transaction
+			_, err := tx.Exec(ctx, query, enumerator.args...)
+			if err != nil {
+				return fmt.Errorf("failed to edit flower in product: %w", err)
+			}
 		}
-	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) EditProductsInEvent(req *contracts.AddProductsToEventRequest) error {
-	ctx := context.Background()
-	tx, err := d.pool.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-
-	for _, product := range *req.Products {
-		queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
-		parameterEnumerator.AppendParameter("event_id", req.EventID)
-		parameterEnumerator.AppendParameter("product_id", product.ProductID)
-		parameterEnumerator.AppendParameter("quantity", product.Quantity)
-
-		// Construct the SQL query
-		query := fmt.Sprintf(
-			"UPDATE products_in_events SET quantity = %s WHERE event_id = %s AND product_id = %s",
-			parameterEnumerator.GetParameters(),
-			queryEnumerator.Enumerate(req.EventID),
-			queryEnumerator.Enumerate(product.ProductID),
-		)
-
-		// Execute the query within the transaction
-		_, err = tx.Exec(context.Background(), query, queryEnumerator.args...)
-		if err != nil {
-			tx.Rollback(ctx)
-			return fmt.Errorf("failed to edit product in event: %w", err)
+	// Execute the queries and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityEvent, req.EventID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		for _, product := range *req.Products {
+			enumerator := &parameterEnumerate{}
+
+			// Construct the SQL query
+			query := fmt.Sprintf(
+				"UPDATE event_product SET quantity = %s WHERE event_id = %s AND product_id = %s%s",
+				enumerator.Enumerate(product.Quantity),
+				enumerator.Enumerate(req.EventID),
+				enumerator.Enumerate(product.ProductID),
+				enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID),
+			)
+
+			// Execute the query within the transaction
+			_, err := tx.Exec(ctx, query, enumerator.args...)
+			if err != nil {
+				return fmt.Errorf("failed to edit product in event: %w", err)
+			}
 		}
-	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
+		return nil
+	})
 }
 
 func (d *Dal) GetProductsFromEvent(eventID string) ([]*persistency.EventProduct, error) {
-	query := `SELECT event_id, product_id, quantity FROM event_product WHERE event_id = $1`
+	query := `SELECT event_id, product_id, quantity FROM event_product WHERE event_id = $1 AND tenant_id = NULLIF($2, '')::uuid`
 
-	rows, err := d.pool.Query(context.Background(), query, eventID)
+	rows, err := d.pool.Query(context.Background(), query, eventID, d.pool.tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get products from event: %w", err)
 	}
@@ -611,9 +749,9 @@ func (d *Dal) GetProductsFromEvent(eventID string) ([]*persistency.EventProduct,
 }
 
 func (d *Dal) GetFlowersFromProduct(productID string) ([]*persistency.FlowerInProduct, error) {
-	query := `SELECT flower_id, product_id, num_of_flowers FROM flower_in_product WHERE product_id = $1`
+	query := `SELECT flower_id, product_id, num_of_flowers FROM flower_in_product WHERE product_id = $1 AND tenant_id = NULLIF($2, '')::uuid`
 
-	rows, err := d.pool.Query(context.Background(), query, productID)
+	rows, err := d.pool.Query(context.Background(), query, productID, d.pool.tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get flowers from product: %w", err)
 	}
@@ -639,9 +777,9 @@ func (d *Dal) GetFlowersFromProduct(productID string) ([]*persistency.FlowerInPr
 }
 
 func (d *Dal) GetFlowerPackingOptions(flowerID string) ([]*persistency.FlowerPackageOptions, error) {
-	query := `SELECT flower_id, num_of_flowers, price FROM flower_package_options WHERE flower_id = $1`
+	query := `SELECT flower_id, supplier_id, num_of_flowers, price FROM flower_package_options WHERE flower_id = $1 AND tenant_id = NULLIF($2, '')::uuid`
 
-	rows, err := d.pool.Query(context.Background(), query, flowerID)
+	rows, err := d.pool.Query(context.Background(), query, flowerID, d.pool.tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get flower packing options: %w", err)
 	}
@@ -652,7 +790,7 @@ func (d *Dal) GetFlowerPackingOptions(flowerID string) ([]*persistency.FlowerPac
 	// Scan the results into a slice of FlowerPackageOptions
 	for rows.Next() {
 		var flowerPackageOption persistency.FlowerPackageOptions
-		if err := rows.Scan(&flowerPackageOption.FlowerID, &flowerPackageOption.NumOfFlowers, &flowerPackageOption.Price); err != nil {
+		if err := rows.Scan(&flowerPackageOption.FlowerID, &flowerPackageOption.SupplierID, &flowerPackageOption.NumOfFlowers, &flowerPackageOption.Price); err != nil {
 			return nil, fmt.Errorf("failed to scan FlowerPackageOptions: %w", err)
 		}
 		FlowerPackageOptions = append(FlowerPackageOptions, &flowerPackageOption)
@@ -665,3 +803,126 @@ func (d *Dal) GetFlowerPackingOptions(flowerID string) ([]*persistency.FlowerPac
 
 	return FlowerPackageOptions, nil
 }
+
+// GetProductsFromEvents returns the products of several events in one query
+func (d *Dal) GetProductsFromEvents(eventIDs []string) ([]*persistency.EventProduct, error) {
+	query := `SELECT event_id, product_id, quantity FROM event_product WHERE event_id = ANY($1) AND tenant_id = NULLIF($2, '')::uuid`
+
+	rows, err := d.pool.Query(context.Background(), query, eventIDs, d.pool.tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products from events: %w", err)
+	}
+	defer rows.Close()
+
+	var productsInEvents []*persistency.EventProduct
+
+	// Scan the results into a slice of EventProduct
+	for rows.Next() {
+		var productInEvent persistency.EventProduct
+		if err := rows.Scan(&productInEvent.EventID, &productInEvent.ProductID, &productInEvent.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan EventProduct: %w", err)
+		}
+		productsInEvents = append(productsInEvents, &productInEvent)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over products in events: %w", err)
+	}
+
+	return productsInEvents, nil
+}
+
+// GetFlowersFromProducts returns the flowers of several products in one query
+func (d *Dal) GetFlowersFromProducts(productIDs []string) ([]*persistency.FlowerInProduct, error) {
+	query := `SELECT flower_id, product_id, num_of_flowers FROM flower_in_product WHERE product_id = ANY($1) AND tenant_id = NULLIF($2, '')::uuid`
+
+	rows, err := d.pool.Query(context.Background(), query, productIDs, d.pool.tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flowers from products: %w", err)
+	}
+	defer rows.Close()
+
+	var flowersInProducts []*persistency.FlowerInProduct
+
+	// Scan the results into a slice of FlowerInProduct
+	for rows.Next() {
+		var flowerInProduct persistency.FlowerInProduct
+		if err := rows.Scan(&flowerInProduct.FlowerID, &flowerInProduct.ProductID, &flowerInProduct.NumOfFlowers); err != nil {
+			return nil, fmt.Errorf("failed to scan FlowerInProduct: %w", err)
+		}
+		flowersInProducts = append(flowersInProducts, &flowerInProduct)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over flowers in products: %w", err)
+	}
+
+	return flowersInProducts, nil
+}
+
+// GetFlowersPackingOptions returns the packing options of several flowers in one query
+func (d *Dal) GetFlowersPackingOptions(flowerIDs []string) ([]*persistency.FlowerPackageOptions, error) {
+	query := `SELECT flower_id, supplier_id, num_of_flowers, price FROM flower_package_options WHERE flower_id = ANY($1) AND tenant_id = NULLIF($2, '')::uuid`
+
+	rows, err := d.pool.Query(context.Background(), query, flowerIDs, d.pool.tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flowers packing options: %w", err)
+	}
+	defer rows.Close()
+
+	var packingOptions []*persistency.FlowerPackageOptions
+
+	// Scan the results into a slice of FlowerPackageOptions
+	for rows.Next() {
+		var packingOption persistency.FlowerPackageOptions
+		if err := rows.Scan(&packingOption.FlowerID, &packingOption.SupplierID, &packingOption.NumOfFlowers, &packingOption.Price); err != nil {
+			return nil, fmt.Errorf("failed to scan FlowerPackageOptions: %w", err)
+		}
+		packingOptions = append(packingOptions, &packingOption)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over flowers packing options: %w", err)
+	}
+
+	return packingOptions, nil
+}
+
+const eventColumns = "id, tenant_id, name, date, status, customer_id, phone, email, address, description, deleted_at"
+
+func scanEvent(row pgx.Row) (*persistency.Event, error) {
+	var event persistency.Event
+	var customerID *string
+	err := row.Scan(&event.ID, &event.TenantID, &event.Name, &event.Date, &event.Status, &customerID, &event.Phone, &event.Email, &event.Address, &event.Description, &event.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	if customerID != nil {
+		event.CustomerID = *customerID
+	}
+
+	return &event, nil
+}
+
+func scanEvents(rows pgx.Rows) ([]*persistency.Event, error) {
+	var events []*persistency.Event
+
+	// Scan the results into a slice of Event
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,102 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	persistency "flower-management/internal/persistency/contracts"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres error codes of the constraint violations reported as typed errors
+const (
+	uniqueViolation          = "23505"
+	foreignKeyViolation      = "23503"
+	notNullViolation         = "23502"
+	checkViolation           = "23514"
+	stringDataRightTruncated = "22001"
+	invalidTextRepresention  = "22P02"
+)
+
+// keyColumns reads the columns out of the detail of a key violation, as in "Key (username)=(dana) already exists."
+var keyColumns = regexp.MustCompile(`^Key \(([^)]+)\)`)
+
+// violationMessages are the messages of the constraint violations. The detail reported by Postgres
+// holds the values of the keys, including IDs of other tenants, so it stays in the wrapped error for the
+// logs and only the names of the columns are returned.
+var violationMessages = map[string]string{
+	uniqueViolation:          "a row with the same values already exists",
+	foreignKeyViolation:      "a referenced row does not exist or is still referenced",
+	notNullViolation:         "a required value is missing",
+	checkViolation:           "a value is not allowed",
+	stringDataRightTruncated: "a value is too long",
+	invalidTextRepresention:  "a value is malformed",
+}
+
+// classifyError turns the constraint violations reported by Postgres into the typed errors of the
+// persistency, other errors are returned as is.
+func classifyError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	var kind error
+	switch pgErr.Code {
+	case uniqueViolation:
+		kind = persistency.ErrConflict
+	case foreignKeyViolation:
+		kind = persistency.ErrForeignKeyViolation
+	case notNullViolation, checkViolation, stringDataRightTruncated, invalidTextRepresention:
+		kind = persistency.ErrValidation
+	default:
+		return err
+	}
+
+	message := violationMessages[pgErr.Code]
+	fields := make(map[string]string)
+	if pgErr.ColumnName != "" {
+		fields[pgErr.ColumnName] = message
+	}
+	if match := keyColumns.FindStringSubmatch(pgErr.Detail); match != nil {
+		for _, column := range strings.Split(match[1], ",") {
+			fields[strings.TrimSpace(column)] = message
+		}
+	}
+
+	return &persistency.Error{Kind: kind, Message: message, Fields: fields, Err: err}
+}
+
+// classifiedTx reports the constraint violations of its statements as typed errors
+type classifiedTx struct {
+	pgx.Tx
+}
+
+func (t *classifiedTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	result, err := t.Tx.Exec(ctx, sql, args...)
+	return result, classifyError(err)
+}
+
+func (t *classifiedTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	rows, err := t.Tx.Query(ctx, sql, args...)
+	return rows, classifyError(err)
+}
+
+func (t *classifiedTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return &classifiedRow{row: t.Tx.QueryRow(ctx, sql, args...)}
+}
+
+func (t *classifiedTx) Commit(ctx context.Context) error {
+	return classifyError(t.Tx.Commit(ctx))
+}
+
+type classifiedRow struct {
+	row pgx.Row
+}
+
+func (r *classifiedRow) Scan(dest ...any) error {
+	return classifyError(r.row.Scan(dest...))
+}
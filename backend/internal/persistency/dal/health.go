@@ -0,0 +1,78 @@
+package dal
+
+import (
+	"context"
+	"flower-management/db"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+)
+
+// CheckHealth pings the database and compares the changesets Liquibase recorded with those of the
+// changelog. The pool statistics are returned even when the database does not answer.
+func (d *Dal) CheckHealth(ctx context.Context) (*persistency.Health, error) {
+	stat := d.pool.pool.Stat()
+	health := &persistency.Health{
+		Pool: persistency.PoolStats{
+			MaxConns:          int(stat.MaxConns()),
+			TotalConns:        int(stat.TotalConns()),
+			IdleConns:         int(stat.IdleConns()),
+			AcquiredConns:     int(stat.AcquiredConns()),
+			AcquireCount:      stat.AcquireCount(),
+			EmptyAcquireCount: stat.EmptyAcquireCount(),
+			AcquireDuration:   stat.AcquireDuration(),
+		},
+	}
+
+	if err := d.pool.pool.Ping(ctx); err != nil {
+		return health, fmt.Errorf("failed to ping the database: %w", err)
+	}
+
+	migrations, err := d.migrationStatus(ctx)
+	if err != nil {
+		return health, err
+	}
+	health.Migrations = *migrations
+
+	return health, nil
+}
+
+func (d *Dal) migrationStatus(ctx context.Context) (*persistency.MigrationStatus, error) {
+	changeSets, err := db.ChangeSets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the changelog: %w", err)
+	}
+
+	// databasechangelog is not owned by a tenant, the query runs without one
+	rows, err := d.pool.pool.Query(ctx, "SELECT id, author FROM databasechangelog ORDER BY orderexecuted")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the applied changesets: %w", err)
+	}
+	defer rows.Close()
+
+	status := &persistency.MigrationStatus{}
+	applied := make(map[db.ChangeSet]bool)
+
+	// Scan the results into the set of applied changesets
+	for rows.Next() {
+		var changeSet db.ChangeSet
+		if err := rows.Scan(&changeSet.ID, &changeSet.Author); err != nil {
+			return nil, fmt.Errorf("failed to scan changeset: %w", err)
+		}
+		applied[changeSet] = true
+		status.Applied++
+		status.Latest = changeSet.ID
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over changesets: %w", err)
+	}
+
+	for _, changeSet := range changeSets {
+		if !applied[changeSet] {
+			status.Pending = append(status.Pending, changeSet.ID)
+		}
+	}
+
+	return status, nil
+}
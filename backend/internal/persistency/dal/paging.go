@@ -0,0 +1,74 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"strings"
+)
+
+// pageClause returns the keyset condition, the ORDER BY and the LIMIT of a page. One row more than
+// the limit is selected so the caller knows whether another page follows.
+func pageClause(enumerator *parameterEnumerate, page *contracts.PageRequest, fields map[string]persistency.SortKind) (string, []contracts.SortField, error) {
+	sort, err := persistency.SortWithID(page.Sort, fields)
+	if err != nil {
+		return "", nil, err
+	}
+
+	clause := ""
+	if page.Cursor != "" {
+		cursorValues, err := persistency.DecodeCursor(page.Cursor, sort)
+		if err != nil {
+			return "", nil, err
+		}
+
+		values := make([]interface{}, len(sort))
+		for i, sortField := range sort {
+			values[i], err = persistency.ParseSortValue(fields[sortField.Field], cursorValues[i])
+			if err != nil {
+				return "", nil, err
+			}
+		}
+		clause += enumerator.CreateKeysetCondition(sort, values)
+	}
+
+	order := make([]string, len(sort))
+	for i, sortField := range sort {
+		order[i] = sortField.Field
+		if sortField.Descending {
+			order[i] += " DESC"
+		}
+	}
+	clause += " ORDER BY " + strings.Join(order, ", ")
+
+	if page.Limit > 0 {
+		clause += " LIMIT " + enumerator.Enumerate(page.Limit+1)
+	}
+
+	return clause, sort, nil
+}
+
+// countRows returns the number of rows of table matching conditions
+func (d *Dal) countRows(table, conditions string, args []interface{}) (int, error) {
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE 1=1%s", table, conditions)
+
+	var total int
+	err := d.pool.QueryRow(context.Background(), query, args...).Scan(&total)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count %s: %w", table, err)
+	}
+
+	return total, nil
+}
+
+// nextPage cuts the extra row selected by pageClause and points the cursor at the last returned row.
+func nextPage[T persistency.Sortable](rows []T, limit int, sort []contracts.SortField, total int) ([]T, *persistency.Page) {
+	page := &persistency.Page{Total: total}
+	if limit > 0 && len(rows) > limit {
+		rows = rows[:limit]
+		page.NextCursor = persistency.NewCursor(rows[len(rows)-1], sort)
+	}
+
+	return rows, page
+}
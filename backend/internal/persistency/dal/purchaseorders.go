@@ -0,0 +1,269 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreatePurchaseOrders replaces the draft purchase orders of an event with the given ones.
+// Orders that were already sent to a supplier are kept.
+func (d *Dal) CreatePurchaseOrders(eventID string, purchaseOrders []*persistency.PurchaseOrder) error {
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := d.deleteDraftPurchaseOrders(ctx, tx, eventID); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, purchaseOrder := range purchaseOrders {
+		purchaseOrder.ID = uuid.New().String()
+		purchaseOrder.TenantID = d.pool.tenantID
+		purchaseOrder.EventID = eventID
+		purchaseOrder.CreatedAt = now
+		purchaseOrder.UpdatedAt = now
+
+		queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+		parameterEnumerator.AppendParameter("id", purchaseOrder.ID)
+		parameterEnumerator.AppendParameter("tenant_id", purchaseOrder.TenantID)
+		parameterEnumerator.AppendParameter("event_id", purchaseOrder.EventID)
+		parameterEnumerator.AppendParameter("supplier_id", purchaseOrder.SupplierID)
+		parameterEnumerator.AppendParameter("status", purchaseOrder.Status)
+		parameterEnumerator.AppendParameter("total_price", purchaseOrder.TotalPrice)
+		parameterEnumerator.AppendParameter("total_packages", purchaseOrder.TotalPackages)
+		parameterEnumerator.AppendParameter("total_flowers", purchaseOrder.TotalFlowers)
+		parameterEnumerator.AppendParameter("created_at", purchaseOrder.CreatedAt)
+		parameterEnumerator.AppendParameter("updated_at", purchaseOrder.UpdatedAt)
+
+		// Construct the SQL query
+		query := fmt.Sprintf(
+			"INSERT INTO purchase_orders (%s) VALUES (%s)",
+			parameterEnumerator.GetColumns(),
+			parameterEnumerator.GetParameters(),
+		)
+
+		// Execute the query within the transaction
+		_, err = tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("failed to create purchase order: %w", err)
+		}
+
+		for _, line := range purchaseOrder.Lines {
+			line.PurchaseOrderID = purchaseOrder.ID
+
+			lineQueryEnumerator, lineParameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+			lineParameterEnumerator.AppendParameter("tenant_id", purchaseOrder.TenantID)
+			lineParameterEnumerator.AppendParameter("purchase_order_id", line.PurchaseOrderID)
+			lineParameterEnumerator.AppendParameter("flower_id", line.FlowerID)
+			lineParameterEnumerator.AppendParameter("flower_name", line.FlowerName)
+			lineParameterEnumerator.AppendParameter("num_of_flowers_in_package", line.NumOfFlowersInPackage)
+			lineParameterEnumerator.AppendParameter("num_of_packages", line.NumOfPackages)
+			lineParameterEnumerator.AppendParameter("price", line.Price)
+
+			// Construct the SQL query
+			lineQuery := fmt.Sprintf(
+				"INSERT INTO purchase_order_lines (%s) VALUES (%s)",
+				lineParameterEnumerator.GetColumns(),
+				lineParameterEnumerator.GetParameters(),
+			)
+
+			// Execute the query within the transaction
+			_, err = tx.Exec(ctx, lineQuery, lineQueryEnumerator.args...)
+			if err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("failed to create purchase order line: %w", err)
+			}
+		}
+
+		err = d.writeAudit(ctx, tx, contracts.AuditEntityPurchaseOrder, purchaseOrder.ID, contracts.AuditActionCreate, nil)
+		if err != nil {
+			tx.Rollback(ctx)
+			return err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// deleteDraftPurchaseOrders deletes the draft orders of an event one by one, recording each in the audit log
+func (d *Dal) deleteDraftPurchaseOrders(ctx context.Context, tx pgx.Tx, eventID string) error {
+	rows, err := tx.Query(ctx, "SELECT id FROM purchase_orders WHERE event_id = $1 AND status = $2 AND tenant_id = NULLIF($3, '')::uuid", eventID, contracts.PurchaseOrderStatusDraft, d.pool.tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft purchase orders: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("failed to scan draft purchase orders: %w", err)
+	}
+
+	for _, id := range ids {
+		before, err := d.snapshotEntity(ctx, tx, contracts.AuditEntityPurchaseOrder, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, "DELETE FROM purchase_orders WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid", id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to delete draft purchase order: %w", err)
+		}
+
+		if err := d.writeAudit(ctx, tx, contracts.AuditEntityPurchaseOrder, id, contracts.AuditActionDelete, before); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dal) GetPurchaseOrder(id string) (*persistency.PurchaseOrder, error) {
+	query := `SELECT id, tenant_id, event_id, supplier_id, status, total_price, total_packages, total_flowers, created_at, updated_at
+		FROM purchase_orders WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid`
+
+	// Execute the query
+	row := d.pool.QueryRow(context.Background(), query, id, d.pool.tenantID)
+
+	// Create a PurchaseOrder instance to hold the result
+	var purchaseOrder persistency.PurchaseOrder
+
+	// Scan the result into the purchase order instance
+	err := row.Scan(&purchaseOrder.ID, &purchaseOrder.TenantID, &purchaseOrder.EventID, &purchaseOrder.SupplierID, &purchaseOrder.Status,
+		&purchaseOrder.TotalPrice, &purchaseOrder.TotalPackages, &purchaseOrder.TotalFlowers,
+		&purchaseOrder.CreatedAt, &purchaseOrder.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("purchase order with ID %s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to get purchase order: %w", err)
+	}
+
+	if err := d.loadPurchaseOrderLines([]*persistency.PurchaseOrder{&purchaseOrder}); err != nil {
+		return nil, err
+	}
+
+	return &purchaseOrder, nil
+}
+
+func (d *Dal) GetFilteredPurchaseOrders(req *contracts.GetFilteredPurchaseOrdersRequest) ([]*persistency.PurchaseOrder, error) {
+	query := `SELECT id, tenant_id, event_id, supplier_id, status, total_price, total_packages, total_flowers, created_at, updated_at
+		FROM purchase_orders WHERE 1=1`
+	enumerator := &parameterEnumerate{}
+	query += enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+
+	if req.EventID != "" {
+		query += enumerator.CreateExactCondition("event_id", req.EventID)
+	}
+	if req.SupplierID != "" {
+		query += enumerator.CreateExactCondition("supplier_id", req.SupplierID)
+	}
+	if req.Status != "" {
+		query += enumerator.CreateExactCondition("status", req.Status)
+	}
+	query += " ORDER BY created_at, id"
+
+	// Prepare the query with parameters
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filtered purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	var purchaseOrders []*persistency.PurchaseOrder
+
+	// Scan the results into a slice of PurchaseOrder
+	for rows.Next() {
+		var purchaseOrder persistency.PurchaseOrder
+		if err := rows.Scan(&purchaseOrder.ID, &purchaseOrder.TenantID, &purchaseOrder.EventID, &purchaseOrder.SupplierID, &purchaseOrder.Status,
+			&purchaseOrder.TotalPrice, &purchaseOrder.TotalPackages, &purchaseOrder.TotalFlowers,
+			&purchaseOrder.CreatedAt, &purchaseOrder.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order: %w", err)
+		}
+		purchaseOrders = append(purchaseOrders, &purchaseOrder)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over purchase orders: %w", err)
+	}
+
+	if err := d.loadPurchaseOrderLines(purchaseOrders); err != nil {
+		return nil, err
+	}
+
+	return purchaseOrders, nil
+}
+
+func (d *Dal) UpdatePurchaseOrderStatus(id string, status contracts.PurchaseOrderStatus) error {
+	query := "UPDATE purchase_orders SET status = $1, updated_at = $2 WHERE id = $3 AND tenant_id = NULLIF($4, '')::uuid"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityPurchaseOrder, id, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, status, time.Now().UTC(), id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to update purchase order status: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("purchase order with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+// loadPurchaseOrderLines fills the lines of all the given purchase orders with a single query.
+func (d *Dal) loadPurchaseOrderLines(purchaseOrders []*persistency.PurchaseOrder) error {
+	if len(purchaseOrders) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(purchaseOrders))
+	byID := make(map[string]*persistency.PurchaseOrder, len(purchaseOrders))
+	for _, purchaseOrder := range purchaseOrders {
+		purchaseOrder.Lines = []*persistency.PurchaseOrderLine{}
+		ids = append(ids, purchaseOrder.ID)
+		byID[purchaseOrder.ID] = purchaseOrder
+	}
+
+	query := `SELECT purchase_order_id, flower_id, flower_name, num_of_flowers_in_package, num_of_packages, price
+		FROM purchase_order_lines WHERE purchase_order_id = ANY($1) AND tenant_id = NULLIF($2, '')::uuid
+		ORDER BY flower_name, num_of_flowers_in_package DESC`
+
+	rows, err := d.pool.Query(context.Background(), query, ids, d.pool.tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get purchase order lines: %w", err)
+	}
+	defer rows.Close()
+
+	// Scan the results into the lines of their purchase order
+	for rows.Next() {
+		var line persistency.PurchaseOrderLine
+		if err := rows.Scan(&line.PurchaseOrderID, &line.FlowerID, &line.FlowerName,
+			&line.NumOfFlowersInPackage, &line.NumOfPackages, &line.Price); err != nil {
+			return fmt.Errorf("failed to scan PurchaseOrderLine: %w", err)
+		}
+		byID[line.PurchaseOrderID].Lines = append(byID[line.PurchaseOrderID].Lines, &line)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error occurred while iterating over purchase order lines: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// purgeTables lists the soft deleted tables in the order they are purged. Events go first together with
+// their purchase orders, quotes and the rows linking them to products. A product or flower still
+// referenced by a row that stays, such as a product of a live event or a flower on a purchase order,
+// is skipped rather than removed from under it, it is purged once nothing references it anymore.
+var purgeTables = []struct {
+	table      string
+	entityType contracts.AuditEntityType
+	referenced string
+}{
+	{"events", contracts.AuditEntityEvent, "false"},
+	{"products", contracts.AuditEntityProduct, "EXISTS (SELECT 1 FROM event_product ep WHERE ep.product_id = t.id AND ep.tenant_id = t.tenant_id)"},
+	{"flowers", contracts.AuditEntityFlower, `EXISTS (SELECT 1 FROM flower_in_product fp WHERE fp.flower_id = t.id AND fp.tenant_id = t.tenant_id)
+		OR EXISTS (SELECT 1 FROM purchase_order_lines l WHERE l.flower_id = t.id AND l.tenant_id = t.tenant_id)
+		OR EXISTS (SELECT 1 FROM stock_lots sl WHERE sl.flower_id = t.id AND sl.tenant_id = t.tenant_id)`},
+}
+
+// PurgeDeleted permanently removes the flowers, products and events of the tenant of the Dal deleted
+// before the given time in a single transaction, every removed row is recorded in the audit log.
+// Rows still referenced are kept. It returns the number of removed rows.
+func (d *Dal) PurgeDeleted(before time.Time) (int, error) {
+	if d.pool.tenantID == "" {
+		return 0, persistency.Invalid("deleted rows are purged one tenant at a time")
+	}
+
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	purged := 0
+	for _, purgeTable := range purgeTables {
+		query := fmt.Sprintf("SELECT id FROM %s t WHERE tenant_id = $1 AND deleted_at < $2 AND NOT (%s) FOR UPDATE",
+			purgeTable.table, purgeTable.referenced)
+		rows, err := tx.Query(ctx, query, d.pool.tenantID, before)
+		if err != nil {
+			tx.Rollback(ctx)
+			return 0, fmt.Errorf("failed to get deleted %s: %w", purgeTable.table, err)
+		}
+
+		ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			tx.Rollback(ctx)
+			return 0, fmt.Errorf("failed to scan deleted %s: %w", purgeTable.table, err)
+		}
+
+		deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND tenant_id = $2", purgeTable.table)
+		for _, id := range ids {
+			snapshot, err := d.snapshotEntity(ctx, tx, purgeTable.entityType, id)
+			if err != nil {
+				tx.Rollback(ctx)
+				return 0, err
+			}
+
+			// Execute the query within the transaction
+			_, err = tx.Exec(ctx, deleteQuery, id, d.pool.tenantID)
+			if err != nil {
+				tx.Rollback(ctx)
+				return 0, fmt.Errorf("failed to purge %s: %w", purgeTable.entityType, err)
+			}
+
+			err = d.writeAudit(ctx, tx, purgeTable.entityType, id, contracts.AuditActionPurge, snapshot)
+			if err != nil {
+				tx.Rollback(ctx)
+				return 0, err
+			}
+			purged++
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,257 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const quoteColumns = `id, tenant_id, event_id, version, status, strategy, event_name, event_date, markup_percent, tax_percent,
+	subtotal, tax, grand_total, created_at, updated_at`
+
+// CreateQuote stores a quote as the next version of its event. The event row is locked while the
+// version is picked so concurrent quotes of the same event get distinct versions.
+func (d *Dal) CreateQuote(quote *persistency.Quote) error {
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	var eventID string
+	err = tx.QueryRow(ctx, "SELECT id FROM events WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid FOR UPDATE", quote.EventID, d.pool.tenantID).Scan(&eventID)
+	if err != nil {
+		tx.Rollback(ctx)
+		if err == pgx.ErrNoRows {
+			return persistency.NotFound("event with ID %s does not exist", quote.EventID)
+		}
+		return fmt.Errorf("failed to lock event: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) + 1 FROM quotes WHERE event_id = $1 AND tenant_id = NULLIF($2, '')::uuid", quote.EventID, d.pool.tenantID).Scan(&quote.Version)
+	if err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("failed to get next quote version: %w", err)
+	}
+
+	quote.ID = uuid.New().String()
+	quote.TenantID = d.pool.tenantID
+	quote.CreatedAt = time.Now().UTC()
+	quote.UpdatedAt = quote.CreatedAt
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", quote.ID)
+	parameterEnumerator.AppendParameter("tenant_id", quote.TenantID)
+	parameterEnumerator.AppendParameter("event_id", quote.EventID)
+	parameterEnumerator.AppendParameter("version", quote.Version)
+	parameterEnumerator.AppendParameter("status", quote.Status)
+	parameterEnumerator.AppendParameter("strategy", quote.Strategy)
+	parameterEnumerator.AppendParameter("event_name", quote.EventName)
+	parameterEnumerator.AppendParameter("event_date", quote.EventDate)
+	parameterEnumerator.AppendParameter("markup_percent", quote.MarkupPercent)
+	parameterEnumerator.AppendParameter("tax_percent", quote.TaxPercent)
+	parameterEnumerator.AppendParameter("subtotal", quote.Subtotal)
+	parameterEnumerator.AppendParameter("tax", quote.Tax)
+	parameterEnumerator.AppendParameter("grand_total", quote.GrandTotal)
+	parameterEnumerator.AppendParameter("created_at", quote.CreatedAt)
+	parameterEnumerator.AppendParameter("updated_at", quote.UpdatedAt)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO quotes (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query within the transaction
+	_, err = tx.Exec(ctx, query, queryEnumerator.args...)
+	if err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("failed to create quote: %w", err)
+	}
+
+	for _, lineItem := range quote.LineItems {
+		lineItem.QuoteID = quote.ID
+
+		lineQueryEnumerator, lineParameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+		lineParameterEnumerator.AppendParameter("tenant_id", quote.TenantID)
+		lineParameterEnumerator.AppendParameter("quote_id", lineItem.QuoteID)
+		lineParameterEnumerator.AppendParameter("product_id", lineItem.ProductID)
+		lineParameterEnumerator.AppendParameter("product_name", lineItem.ProductName)
+		lineParameterEnumerator.AppendParameter("quantity", lineItem.Quantity)
+		lineParameterEnumerator.AppendParameter("flower_cost_per_unit", lineItem.FlowerCostPerUnit)
+		lineParameterEnumerator.AppendParameter("labor_cost_per_unit", lineItem.LaborCostPerUnit)
+		lineParameterEnumerator.AppendParameter("unit_price", lineItem.UnitPrice)
+		lineParameterEnumerator.AppendParameter("line_total", lineItem.LineTotal)
+
+		// Construct the SQL query
+		lineQuery := fmt.Sprintf(
+			"INSERT INTO quote_line_items (%s) VALUES (%s)",
+			lineParameterEnumerator.GetColumns(),
+			lineParameterEnumerator.GetParameters(),
+		)
+
+		// Execute the query within the transaction
+		_, err = tx.Exec(ctx, lineQuery, lineQueryEnumerator.args...)
+		if err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("failed to create quote line item: %w", err)
+		}
+	}
+
+	err = d.writeAudit(ctx, tx, contracts.AuditEntityQuote, quote.ID, contracts.AuditActionCreate, nil)
+	if err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (d *Dal) GetQuote(id string) (*persistency.Quote, error) {
+	query := fmt.Sprintf("SELECT %s FROM quotes WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid", quoteColumns)
+
+	quote, err := scanQuote(d.pool.QueryRow(context.Background(), query, id, d.pool.tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("quote with ID %s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	if err := d.loadQuoteLineItems([]*persistency.Quote{quote}); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+func (d *Dal) GetQuoteByVersion(eventID string, version int) (*persistency.Quote, error) {
+	query := fmt.Sprintf("SELECT %s FROM quotes WHERE event_id = $1 AND version = $2 AND tenant_id = NULLIF($3, '')::uuid", quoteColumns)
+
+	quote, err := scanQuote(d.pool.QueryRow(context.Background(), query, eventID, version, d.pool.tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("quote version %d of event %s does not exist", version, eventID)
+		}
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	if err := d.loadQuoteLineItems([]*persistency.Quote{quote}); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+func (d *Dal) GetEventQuotes(eventID string) ([]*persistency.Quote, error) {
+	query := fmt.Sprintf("SELECT %s FROM quotes WHERE event_id = $1 AND tenant_id = NULLIF($2, '')::uuid ORDER BY version", quoteColumns)
+
+	rows, err := d.pool.Query(context.Background(), query, eventID, d.pool.tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event quotes: %w", err)
+	}
+	defer rows.Close()
+
+	var quotes []*persistency.Quote
+
+	// Scan the results into a slice of Quote
+	for rows.Next() {
+		quote, err := scanQuote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan quote: %w", err)
+		}
+		quotes = append(quotes, quote)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over quotes: %w", err)
+	}
+
+	if err := d.loadQuoteLineItems(quotes); err != nil {
+		return nil, err
+	}
+
+	return quotes, nil
+}
+
+func (d *Dal) UpdateQuoteStatus(id string, status contracts.QuoteStatus) error {
+	query := "UPDATE quotes SET status = $1, updated_at = $2 WHERE id = $3 AND tenant_id = NULLIF($4, '')::uuid"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityQuote, id, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, status, time.Now().UTC(), id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to update quote status: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("quote with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func scanQuote(row pgx.Row) (*persistency.Quote, error) {
+	var quote persistency.Quote
+	err := row.Scan(&quote.ID, &quote.TenantID, &quote.EventID, &quote.Version, &quote.Status, &quote.Strategy, &quote.EventName,
+		&quote.EventDate, &quote.MarkupPercent, &quote.TaxPercent, &quote.Subtotal, &quote.Tax, &quote.GrandTotal,
+		&quote.CreatedAt, &quote.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// loadQuoteLineItems fills the line items of all the given quotes with a single query.
+func (d *Dal) loadQuoteLineItems(quotes []*persistency.Quote) error {
+	if len(quotes) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(quotes))
+	byID := make(map[string]*persistency.Quote, len(quotes))
+	for _, quote := range quotes {
+		quote.LineItems = []*persistency.QuoteLineItem{}
+		ids = append(ids, quote.ID)
+		byID[quote.ID] = quote
+	}
+
+	query := `SELECT quote_id, product_id, product_name, quantity, flower_cost_per_unit, labor_cost_per_unit, unit_price, line_total
+		FROM quote_line_items WHERE quote_id = ANY($1) AND tenant_id = NULLIF($2, '')::uuid ORDER BY product_name, product_id`
+
+	rows, err := d.pool.Query(context.Background(), query, ids, d.pool.tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get quote line items: %w", err)
+	}
+	defer rows.Close()
+
+	// Scan the results into the line items of their quote
+	for rows.Next() {
+		var lineItem persistency.QuoteLineItem
+		if err := rows.Scan(&lineItem.QuoteID, &lineItem.ProductID, &lineItem.ProductName, &lineItem.Quantity,
+			&lineItem.FlowerCostPerUnit, &lineItem.LaborCostPerUnit, &lineItem.UnitPrice, &lineItem.LineTotal); err != nil {
+			return fmt.Errorf("failed to scan QuoteLineItem: %w", err)
+		}
+		byID[lineItem.QuoteID].LineItems = append(byID[lineItem.QuoteID].LineItems, &lineItem)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error occurred while iterating over quote line items: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// The headline of a hit wraps the matched words in control characters, the snippet is HTML escaped
+// before they are turned into <mark> tags so the text of a row cannot inject markup.
+const (
+	startSel = "\x02"
+	stopSel  = "\x03"
+)
+
+// searchHeadlineOptions marks the matched words and keeps snippets short
+const searchHeadlineOptions = "StartSel=\"" + startSel + "\", StopSel=\"" + stopSel + "\", MaxWords=25, MinWords=8, MaxFragments=2, FragmentDelimiter=\" ... \""
+
+var snippetMarks = strings.NewReplacer(startSel, "<mark>", stopSel, "</mark>")
+
+// searchQuery matches the search_vector columns created by the changelog. Every entity type is ranked
+// and limited on its own, the 'simple' configuration is used since names are not only English words.
+const searchQuery = `WITH search AS (SELECT websearch_to_tsquery('simple', $1) AS query)
+	(SELECT 'flower', f.id, f.name,
+		ts_headline('simple', f.name, search.query, $3),
+		ts_rank(f.search_vector, search.query)::float8 AS rank
+		FROM flowers f, search
+		WHERE f.tenant_id = NULLIF($4, '')::uuid AND f.deleted_at IS NULL AND f.search_vector @@ search.query
+		ORDER BY rank DESC, f.name LIMIT $2)
+	UNION ALL
+	(SELECT 'product', p.id, p.name,
+		ts_headline('simple', concat_ws(' - ', p.name, p.description), search.query, $3),
+		ts_rank(p.search_vector, search.query)::float8 AS rank
+		FROM products p, search
+		WHERE p.tenant_id = NULLIF($4, '')::uuid AND p.deleted_at IS NULL AND p.search_vector @@ search.query
+		ORDER BY rank DESC, p.name LIMIT $2)
+	UNION ALL
+	(SELECT 'event', e.id, e.name,
+		ts_headline('simple', concat_ws(' - ', e.name, e.description, e.address), search.query, $3),
+		ts_rank(e.search_vector, search.query)::float8 AS rank
+		FROM events e, search
+		WHERE e.tenant_id = NULLIF($4, '')::uuid AND e.deleted_at IS NULL AND e.search_vector @@ search.query
+		ORDER BY rank DESC, e.name LIMIT $2)`
+
+func (d *Dal) Search(req *contracts.SearchRequest) ([]*persistency.SearchHit, error) {
+	// Execute the query
+	rows, err := d.pool.Query(context.Background(), searchQuery, req.Query, req.Limit, searchHeadlineOptions, d.pool.tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*persistency.SearchHit
+
+	// Scan the results into a slice of SearchHit
+	for rows.Next() {
+		var hit persistency.SearchHit
+		var headline string
+		if err := rows.Scan(&hit.EntityType, &hit.ID, &hit.Title, &headline, &hit.Rank); err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+		hit.Snippet = snippetMarks.Replace(html.EscapeString(headline))
+		hits = append(hits, &hit)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over search hits: %w", err)
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,291 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateStockLot stores a newly received lot together with its receipt entry.
+func (d *Dal) CreateStockLot(lot *persistency.StockLot, receipt *persistency.StockEntry) error {
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	lot.ID = uuid.New().String()
+	lot.TenantID = d.pool.tenantID
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", lot.ID)
+	parameterEnumerator.AppendParameter("tenant_id", lot.TenantID)
+	parameterEnumerator.AppendParameter("flower_id", lot.FlowerID)
+	parameterEnumerator.AppendParameter("received_at", lot.ReceivedAt)
+	parameterEnumerator.AppendParameter("expires_at", lot.ExpiresAt)
+	parameterEnumerator.AppendParameter("quantity", lot.Quantity)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO stock_lots (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query within the transaction
+	_, err = tx.Exec(ctx, query, queryEnumerator.args...)
+	if err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("failed to create stock lot: %w", err)
+	}
+
+	receipt.LotID = lot.ID
+	if err := d.insertStockEntry(ctx, tx, receipt); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	lot.Remaining = lot.Quantity
+
+	err = d.writeAudit(ctx, tx, contracts.AuditEntityStockLot, lot.ID, contracts.AuditActionCreate, nil)
+	if err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// AddStockEntries appends entries to the stock ledger in a single transaction. The lot of every entry
+// is locked while it is checked, so concurrent entries cannot take a lot below zero.
+func (d *Dal) AddStockEntries(entries []*persistency.StockEntry) error {
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, entry := range entries {
+		var flowerID string
+		err = tx.QueryRow(ctx, "SELECT flower_id FROM stock_lots WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid FOR UPDATE", entry.LotID, d.pool.tenantID).Scan(&flowerID)
+		if err != nil {
+			tx.Rollback(ctx)
+			if err == pgx.ErrNoRows {
+				return persistency.NotFound("stock lot with ID %s does not exist", entry.LotID)
+			}
+			return fmt.Errorf("failed to lock stock lot: %w", err)
+		}
+		if flowerID != entry.FlowerID {
+			tx.Rollback(ctx)
+			return persistency.Invalid("stock lot with ID %s does not hold flower %s", entry.LotID, entry.FlowerID)
+		}
+
+		var remaining int
+		err = tx.QueryRow(ctx, "SELECT COALESCE(SUM(quantity), 0) FROM stock_ledger WHERE lot_id = $1 AND tenant_id = NULLIF($2, '')::uuid", entry.LotID, d.pool.tenantID).Scan(&remaining)
+		if err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("failed to get stock lot level: %w", err)
+		}
+		if remaining+entry.Quantity < 0 {
+			tx.Rollback(ctx)
+			return persistency.Conflict("not enough stock in lot %s: %d remaining, %d requested", entry.LotID, remaining, -entry.Quantity)
+		}
+
+		before, err := d.snapshotEntity(ctx, tx, contracts.AuditEntityStockLot, entry.LotID)
+		if err != nil {
+			tx.Rollback(ctx)
+			return err
+		}
+
+		if err := d.insertStockEntry(ctx, tx, entry); err != nil {
+			tx.Rollback(ctx)
+			return err
+		}
+
+		if err := d.writeAudit(ctx, tx, contracts.AuditEntityStockLot, entry.LotID, contracts.AuditActionEdit, before); err != nil {
+			tx.Rollback(ctx)
+			return err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (d *Dal) insertStockEntry(ctx context.Context, tx pgx.Tx, entry *persistency.StockEntry) error {
+	entry.ID = uuid.New().String()
+	entry.TenantID = d.pool.tenantID
+	entry.CreatedAt = time.Now().UTC()
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", entry.ID)
+	parameterEnumerator.AppendParameter("tenant_id", entry.TenantID)
+	parameterEnumerator.AppendParameter("flower_id", entry.FlowerID)
+	parameterEnumerator.AppendParameter("lot_id", entry.LotID)
+	parameterEnumerator.AppendParameter("entry_type", entry.EntryType)
+	parameterEnumerator.AppendParameter("quantity", entry.Quantity)
+	parameterEnumerator.AppendParameter("event_id", nullableString(entry.EventID))
+	parameterEnumerator.AppendParameter("note", entry.Note)
+	parameterEnumerator.AppendParameter("created_at", entry.CreatedAt)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO stock_ledger (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query within the transaction
+	_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+	if err != nil {
+		return fmt.Errorf("failed to add stock entry: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Dal) GetStockEntries(req *contracts.GetStockEntriesRequest) ([]*persistency.StockEntry, error) {
+	query := "SELECT id, tenant_id, flower_id, lot_id, entry_type, quantity, event_id, note, created_at FROM stock_ledger WHERE 1=1"
+	enumerator := &parameterEnumerate{}
+	query += enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+
+	if req.FlowerID != "" {
+		query += enumerator.CreateExactCondition("flower_id", req.FlowerID)
+	}
+	if req.EventID != "" {
+		query += enumerator.CreateExactCondition("event_id", req.EventID)
+	}
+	if req.LotID != "" {
+		query += enumerator.CreateExactCondition("lot_id", req.LotID)
+	}
+	if req.EntryType != "" {
+		query += enumerator.CreateExactCondition("entry_type", req.EntryType)
+	}
+	query += " ORDER BY created_at, id"
+
+	// Prepare the query with parameters
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*persistency.StockEntry
+
+	// Scan the results into a slice of StockEntry
+	for rows.Next() {
+		var entry persistency.StockEntry
+		var eventID *string
+		if err := rows.Scan(&entry.ID, &entry.TenantID, &entry.FlowerID, &entry.LotID, &entry.EntryType, &entry.Quantity, &eventID, &entry.Note, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan stock entry: %w", err)
+		}
+		if eventID != nil {
+			entry.EventID = *eventID
+		}
+		entries = append(entries, &entry)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over stock entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetStockLevels returns the stock on hand of the given flowers, or of every flower in the ledger when none are given.
+func (d *Dal) GetStockLevels(flowerIDs []string) ([]*persistency.StockLevel, error) {
+	enumerator := &parameterEnumerate{}
+	query := "SELECT flower_id, SUM(quantity) FROM stock_ledger WHERE 1=1" + enumerator.CreateTenantCondition("tenant_id", d.pool.tenantID)
+	query += enumerator.CreateAnyCondition("flower_id", flowerIDs)
+	query += " GROUP BY flower_id ORDER BY flower_id"
+
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock levels: %w", err)
+	}
+	defer rows.Close()
+
+	var levels []*persistency.StockLevel
+
+	// Scan the results into a slice of StockLevel
+	for rows.Next() {
+		var level persistency.StockLevel
+		if err := rows.Scan(&level.FlowerID, &level.OnHand); err != nil {
+			return nil, fmt.Errorf("failed to scan stock level: %w", err)
+		}
+		levels = append(levels, &level)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over stock levels: %w", err)
+	}
+
+	return levels, nil
+}
+
+// GetStockLots returns the lots ordered first-expiring-first, lots that never expire come last.
+func (d *Dal) GetStockLots(req *contracts.GetStockLotsRequest) ([]*persistency.StockLot, error) {
+	query := `SELECT l.id, l.tenant_id, l.flower_id, l.received_at, l.expires_at, l.quantity, COALESCE(SUM(s.quantity), 0) AS remaining
+		FROM stock_lots l LEFT JOIN stock_ledger s ON s.lot_id = l.id AND s.tenant_id = l.tenant_id WHERE 1=1`
+	enumerator := &parameterEnumerate{}
+	query += enumerator.CreateTenantCondition("l.tenant_id", d.pool.tenantID)
+
+	if len(req.FlowerIDs) > 0 {
+		query += fmt.Sprintf(" AND l.flower_id = ANY(%s)", enumerator.Enumerate(req.FlowerIDs))
+	}
+	if !req.ExpiringBefore.IsZero() {
+		query += fmt.Sprintf(" AND l.expires_at < %s", enumerator.Enumerate(req.ExpiringBefore))
+	}
+	if !req.FreshAt.IsZero() {
+		query += fmt.Sprintf(" AND (l.expires_at IS NULL OR l.expires_at >= %s)", enumerator.Enumerate(req.FreshAt))
+	}
+	query += " GROUP BY l.id"
+	if req.OnlyAvailable {
+		query += " HAVING COALESCE(SUM(s.quantity), 0) > 0"
+	}
+	query += " ORDER BY l.expires_at ASC NULLS LAST, l.received_at, l.id"
+
+	// Prepare the query with parameters
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock lots: %w", err)
+	}
+	defer rows.Close()
+
+	var lots []*persistency.StockLot
+
+	// Scan the results into a slice of StockLot
+	for rows.Next() {
+		var lot persistency.StockLot
+		if err := rows.Scan(&lot.ID, &lot.TenantID, &lot.FlowerID, &lot.ReceivedAt, &lot.ExpiresAt, &lot.Quantity, &lot.Remaining); err != nil {
+			return nil, fmt.Errorf("failed to scan stock lot: %w", err)
+		}
+		lots = append(lots, &lot)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over stock lots: %w", err)
+	}
+
+	return lots, nil
+}
+
+// nullableString stores empty strings as NULL
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,222 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (d *Dal) CreateSupplier(supplier *persistency.Supplier) error {
+	supplier.ID = uuid.New().String()
+	supplier.TenantID = d.pool.tenantID
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", supplier.ID)
+	parameterEnumerator.AppendParameter("tenant_id", supplier.TenantID)
+	parameterEnumerator.AppendParameter("name", supplier.Name)
+	parameterEnumerator.AppendParameter("phone", supplier.Phone)
+	parameterEnumerator.AppendParameter("email", supplier.Email)
+	parameterEnumerator.AppendParameter("address", supplier.Address)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO suppliers (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntitySupplier, supplier.ID, contracts.AuditActionCreate, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to create supplier: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) EditSupplier(supplier *persistency.Supplier) error {
+	if supplier.ID == persistency.DefaultSupplierID {
+		return persistency.Conflict("the default supplier is shared by every tenant and cannot be changed")
+	}
+
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	supplierIDParameter := queryEnumerator.Enumerate(supplier.ID)
+
+	// Append parameters to the enumerator
+	parameterEnumerator.AppendParameter("name", supplier.Name)
+	parameterEnumerator.AppendParameter("phone", supplier.Phone)
+	parameterEnumerator.AppendParameter("email", supplier.Email)
+	parameterEnumerator.AppendParameter("address", supplier.Address)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"UPDATE suppliers SET %s WHERE id = %s%s",
+		parameterEnumerator.GetAssignedParameters(),
+		supplierIDParameter,
+		queryEnumerator.CreateTenantCondition("tenant_id", d.pool.tenantID))
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntitySupplier, supplier.ID, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to edit supplier: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("supplier with ID %s does not exist", supplier.ID)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) DeleteSupplier(id string) error {
+	// the default supplier is shared by every tenant, deleting it would cascade to the packing options of all of them
+	if id == persistency.DefaultSupplierID {
+		return persistency.Conflict("the default supplier is shared by every tenant and cannot be changed")
+	}
+
+	query := "DELETE FROM suppliers WHERE id = $1 AND tenant_id = NULLIF($2, '')::uuid"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntitySupplier, id, contracts.AuditActionDelete, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to delete supplier: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("supplier with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) GetFilteredSuppliers(req *contracts.GetFilteredSuppliersRequest) ([]*persistency.Supplier, error) {
+	query := "SELECT id, tenant_id, name, phone, email, address FROM suppliers WHERE 1=1"
+	enumerator := &parameterEnumerate{}
+	query += supplierTenantCondition(enumerator, d.pool.tenantID)
+
+	if req.Name != "" {
+		query += enumerator.CreateLikeCondition("name", req.Name)
+	}
+
+	// Prepare the query with parameters
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filtered suppliers: %w", err)
+	}
+	defer rows.Close()
+
+	var suppliers []*persistency.Supplier
+
+	// Scan the results into a slice of Supplier
+	for rows.Next() {
+		var supplier persistency.Supplier
+		if err := rows.Scan(&supplier.ID, &supplier.TenantID, &supplier.Name, &supplier.Phone, &supplier.Email, &supplier.Address); err != nil {
+			return nil, fmt.Errorf("failed to scan supplier: %w", err)
+		}
+		suppliers = append(suppliers, &supplier)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over suppliers: %w", err)
+	}
+
+	return suppliers, nil
+}
+
+func (d *Dal) GetSupplier(id string) (*persistency.Supplier, error) {
+	enumerator := &parameterEnumerate{}
+	query := "SELECT id, tenant_id, name, phone, email, address FROM suppliers WHERE id = " + enumerator.Enumerate(id) +
+		supplierTenantCondition(enumerator, d.pool.tenantID)
+
+	// Execute the query
+	row := d.pool.QueryRow(context.Background(), query, enumerator.args...)
+
+	// Create a Supplier instance to hold the result
+	var supplier persistency.Supplier
+
+	// Scan the result into the supplier instance
+	err := row.Scan(&supplier.ID, &supplier.TenantID, &supplier.Name, &supplier.Phone, &supplier.Email, &supplier.Address)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("supplier with ID %s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to get supplier: %w", err)
+	}
+
+	return &supplier, nil
+}
+
+// SetFlowerPackingOptions replaces the packing options a supplier offers for a flower.
+func (d *Dal) SetFlowerPackingOptions(req *contracts.SetFlowerPackingOptionsRequest) error {
+	ctx := context.Background()
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	before, err := d.snapshotEntity(ctx, tx, contracts.AuditEntityFlower, req.FlowerID)
+	if err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	query := "DELETE FROM flower_package_options WHERE flower_id = $1 AND supplier_id = $2 AND tenant_id = NULLIF($3, '')::uuid"
+	_, err = tx.Exec(ctx, query, req.FlowerID, req.SupplierID, d.pool.tenantID)
+	if err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("failed to clear packing options: %w", err)
+	}
+
+	for _, packingOption := range *req.PackingOptions {
+		queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+		parameterEnumerator.AppendParameter("tenant_id", d.pool.tenantID)
+		parameterEnumerator.AppendParameter("flower_id", req.FlowerID)
+		parameterEnumerator.AppendParameter("supplier_id", req.SupplierID)
+		parameterEnumerator.AppendParameter("num_of_flowers", packingOption.Quantity)
+		parameterEnumerator.AppendParameter("price", packingOption.Price)
+
+		// Construct the SQL query
+		query := fmt.Sprintf(
+			"INSERT INTO flower_package_options (%s) VALUES (%s)",
+			parameterEnumerator.GetColumns(),
+			parameterEnumerator.GetParameters(),
+		)
+
+		// Execute the query within the transaction
+		_, err = tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("failed to create packing option: %w", err)
+		}
+	}
+
+	err = d.writeAudit(ctx, tx, contracts.AuditEntityFlower, req.FlowerID, contracts.AuditActionEdit, before)
+	if err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// supplierTenantCondition keeps the suppliers of the tenant together with the default supplier every tenant
+// shares, like the tenant_read policy of the changelog
+func supplierTenantCondition(enumerator *parameterEnumerate, tenantID string) string {
+	return fmt.Sprintf(" AND (tenant_id = NULLIF(%s, '')::uuid OR id = %s)", enumerator.Enumerate(tenantID), enumerator.Enumerate(persistency.DefaultSupplierID))
+}
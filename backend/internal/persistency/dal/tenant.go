@@ -0,0 +1,237 @@
+package dal
+
+import (
+	"context"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// tenantSetting is the transaction setting the row level security policies of the changelog compare
+// the tenant_id column of every row against
+const tenantSetting = "app.tenant_id"
+
+// tenantPool runs every query of a Dal in a transaction that carries the tenant of the Dal, so the row
+// level security policies let the query see and change the rows of that tenant only. Without a tenant
+// the queries run as is and the policies hide every tenant owned row. The queries of the Dal also compare
+// the tenant_id of every row with the tenant themselves, the policies are the second line of defence.
+type tenantPool struct {
+	pool     *pgxpool.Pool
+	tenantID string
+}
+
+// WithTenant returns a Dal sharing the same pool that only sees and changes the rows of the tenant.
+func (d *Dal) WithTenant(tenantID string) persistency.DalInterface {
+	return &Dal{
+		pool:  &tenantPool{pool: d.pool.pool, tenantID: tenantID},
+		actor: d.actor,
+	}
+}
+
+func (p *tenantPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tenantID != "" {
+		// the setting is local to the transaction so it never leaks to the next user of the connection
+		_, err = tx.Exec(ctx, "SELECT set_config($1, $2, true)", tenantSetting, p.tenantID)
+		if err != nil {
+			tx.Rollback(ctx)
+			return nil, fmt.Errorf("failed to set tenant: %w", err)
+		}
+	}
+
+	return &classifiedTx{Tx: tx}, nil
+}
+
+func (p *tenantPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if p.tenantID == "" {
+		result, err := p.pool.Exec(ctx, sql, args...)
+		return result, classifyError(err)
+	}
+
+	tx, err := p.Begin(ctx)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	result, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		tx.Rollback(ctx)
+		return pgconn.CommandTag{}, err
+	}
+
+	return result, tx.Commit(ctx)
+}
+
+func (p *tenantPool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if p.tenantID == "" {
+		rows, err := p.pool.Query(ctx, sql, args...)
+		return rows, classifyError(err)
+	}
+
+	tx, err := p.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	return &tenantRows{Rows: rows, ctx: ctx, tx: tx}, nil
+}
+
+func (p *tenantPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if p.tenantID == "" {
+		return &classifiedRow{row: p.pool.QueryRow(ctx, sql, args...)}
+	}
+
+	tx, err := p.Begin(ctx)
+	if err != nil {
+		return &tenantRow{err: err}
+	}
+
+	return &tenantRow{row: tx.QueryRow(ctx, sql, args...), ctx: ctx, tx: tx}
+}
+
+func (p *tenantPool) Ping(ctx context.Context) error {
+	return p.pool.Ping(ctx)
+}
+
+func (p *tenantPool) Close() {
+	p.pool.Close()
+}
+
+// tenantRows ends the transaction of its query once the rows are read or closed
+type tenantRows struct {
+	pgx.Rows
+	ctx  context.Context
+	tx   pgx.Tx
+	done bool
+}
+
+func (r *tenantRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+
+	r.finish()
+	return false
+}
+
+func (r *tenantRows) Close() {
+	r.Rows.Close()
+	r.finish()
+}
+
+func (r *tenantRows) finish() {
+	if r.done {
+		return
+	}
+	r.done = true
+
+	if r.Rows.Err() != nil {
+		r.tx.Rollback(r.ctx)
+		return
+	}
+	r.tx.Commit(r.ctx)
+}
+
+// tenantRow ends the transaction of its query once the row is scanned
+type tenantRow struct {
+	row pgx.Row
+	ctx context.Context
+	tx  pgx.Tx
+	err error
+}
+
+func (r *tenantRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	err := r.row.Scan(dest...)
+	if err != nil {
+		r.tx.Rollback(r.ctx)
+		return err
+	}
+
+	return r.tx.Commit(r.ctx)
+}
+
+func (d *Dal) CreateTenant(tenant *persistency.Tenant) error {
+	tenant.ID = uuid.New().String()
+	tenant.CreatedAt = time.Now().UTC()
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", tenant.ID)
+	parameterEnumerator.AppendParameter("name", tenant.Name)
+	parameterEnumerator.AppendParameter("created_at", tenant.CreatedAt)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO tenants (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query
+	_, err := d.pool.Exec(context.Background(), query, queryEnumerator.args...)
+	if err != nil {
+		return fmt.Errorf("failed to create tenant: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Dal) GetTenant(id string) (*persistency.Tenant, error) {
+	query := "SELECT id, name, created_at FROM tenants WHERE id = $1"
+
+	var tenant persistency.Tenant
+	err := d.pool.QueryRow(context.Background(), query, id).Scan(&tenant.ID, &tenant.Name, &tenant.CreatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("tenant with ID %s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	return &tenant, nil
+}
+
+func (d *Dal) GetTenants() ([]*persistency.Tenant, error) {
+	query := "SELECT id, name, created_at FROM tenants ORDER BY name, id"
+
+	rows, err := d.pool.Query(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []*persistency.Tenant
+
+	// Scan the results into a slice of Tenant
+	for rows.Next() {
+		var tenant persistency.Tenant
+		if err := rows.Scan(&tenant.ID, &tenant.Name, &tenant.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tenant: %w", err)
+		}
+		tenants = append(tenants, &tenant)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over tenants: %w", err)
+	}
+
+	return tenants, nil
+}
@@ -0,0 +1,251 @@
+package dal
+
+import (
+	"context"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	userColumns     = "id, tenant_id, username, password_hash, role, created_at"
+	apiTokenColumns = "id, user_id, name, token_hash, created_at, revoked_at"
+)
+
+func (d *Dal) CreateUser(user *persistency.User) error {
+	user.ID = uuid.New().String()
+	user.TenantID = d.pool.tenantID
+	user.CreatedAt = time.Now().UTC()
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", user.ID)
+	parameterEnumerator.AppendParameter("tenant_id", user.TenantID)
+	parameterEnumerator.AppendParameter("username", user.Username)
+	parameterEnumerator.AppendParameter("password_hash", user.PasswordHash)
+	parameterEnumerator.AppendParameter("role", user.Role)
+	parameterEnumerator.AppendParameter("created_at", user.CreatedAt)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO users (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityUser, user.ID, contracts.AuditActionCreate, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) GetUser(id string) (*persistency.User, error) {
+	enumerator := &parameterEnumerate{}
+	query := fmt.Sprintf("SELECT %s FROM users WHERE id = %s", userColumns, enumerator.Enumerate(id)) +
+		enumerator.CreateLoginTenantCondition("tenant_id", d.pool.tenantID)
+
+	// Execute the query
+	user, err := scanUser(d.pool.QueryRow(context.Background(), query, enumerator.args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, persistency.NotFound("user with ID %s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (d *Dal) GetUserByUsername(username string) (*persistency.User, error) {
+	enumerator := &parameterEnumerate{}
+	query := fmt.Sprintf("SELECT %s FROM users WHERE username = %s", userColumns, enumerator.Enumerate(username)) +
+		enumerator.CreateLoginTenantCondition("tenant_id", d.pool.tenantID)
+
+	// Execute the query
+	user, err := scanUser(d.pool.QueryRow(context.Background(), query, enumerator.args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (d *Dal) GetUsers() ([]*persistency.User, error) {
+	enumerator := &parameterEnumerate{}
+	query := fmt.Sprintf("SELECT %s FROM users WHERE 1=1%s ORDER BY username", userColumns, enumerator.CreateLoginTenantCondition("tenant_id", d.pool.tenantID))
+
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*persistency.User
+
+	// Scan the results into a slice of User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over users: %w", err)
+	}
+
+	return users, nil
+}
+
+func (d *Dal) SetUserRole(id string, role contracts.Role) error {
+	query := "UPDATE users SET role = $1 WHERE id = $2 AND tenant_id = NULLIF($3, '')::uuid"
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityUser, id, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, role, id, d.pool.tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to set user role: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("user with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func (d *Dal) CreateAPIToken(token *persistency.APIToken) error {
+	token.ID = uuid.New().String()
+	token.CreatedAt = time.Now().UTC()
+	queryEnumerator, parameterEnumerator := new(parameterEnumerate).WithParameterEnumerate()
+	parameterEnumerator.AppendParameter("id", token.ID)
+	parameterEnumerator.AppendParameter("user_id", token.UserID)
+	parameterEnumerator.AppendParameter("name", token.Name)
+	parameterEnumerator.AppendParameter("token_hash", token.TokenHash)
+	parameterEnumerator.AppendParameter("created_at", token.CreatedAt)
+
+	// Construct the SQL query
+	query := fmt.Sprintf(
+		"INSERT INTO api_tokens (%s) VALUES (%s)",
+		parameterEnumerator.GetColumns(),
+		parameterEnumerator.GetParameters(),
+	)
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityAPIToken, token.ID, contracts.AuditActionCreate, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query, queryEnumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to create api token: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetAPITokenByHash finds a token in every tenant, it authenticates the request that tells the tenant
+func (d *Dal) GetAPITokenByHash(tokenHash string) (*persistency.APIToken, error) {
+	query := fmt.Sprintf("SELECT %s FROM api_tokens WHERE token_hash = $1", apiTokenColumns)
+
+	// Execute the query
+	token, err := scanAPIToken(d.pool.QueryRow(context.Background(), query, tokenHash))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get api token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (d *Dal) GetUserAPITokens(userID string) ([]*persistency.APIToken, error) {
+	// the tokens carry no tenant, they are kept to the users of the tenant
+	enumerator := &parameterEnumerate{}
+	query := fmt.Sprintf(
+		"SELECT %s FROM api_tokens WHERE user_id = %s AND user_id IN (SELECT id FROM users WHERE 1=1%s) ORDER BY created_at, id",
+		apiTokenColumns,
+		enumerator.Enumerate(userID),
+		enumerator.CreateLoginTenantCondition("tenant_id", d.pool.tenantID))
+
+	rows, err := d.pool.Query(context.Background(), query, enumerator.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*persistency.APIToken
+
+	// Scan the results into a slice of APIToken
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api token: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+
+	// Check for errors from iterating over rows
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over api tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (d *Dal) RevokeAPIToken(id string) error {
+	enumerator := &parameterEnumerate{}
+	query := fmt.Sprintf(
+		"UPDATE api_tokens SET revoked_at = %s WHERE id = %s AND revoked_at IS NULL AND user_id IN (SELECT id FROM users WHERE 1=1%s)",
+		enumerator.Enumerate(time.Now().UTC()),
+		enumerator.Enumerate(id),
+		enumerator.CreateLoginTenantCondition("tenant_id", d.pool.tenantID))
+
+	// Execute the query and record the change in the audit log
+	return d.inAuditedTransaction(contracts.AuditEntityAPIToken, id, contracts.AuditActionEdit, func(ctx context.Context, tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, enumerator.args...)
+		if err != nil {
+			return fmt.Errorf("failed to revoke api token: %w", err)
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected() == 0 {
+			return persistency.NotFound("active api token with ID %s does not exist", id)
+		}
+
+		return nil
+	})
+}
+
+func scanUser(row pgx.Row) (*persistency.User, error) {
+	var user persistency.User
+	err := row.Scan(&user.ID, &user.TenantID, &user.Username, &user.PasswordHash, &user.Role, &user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func scanAPIToken(row pgx.Row) (*persistency.APIToken, error) {
+	var token persistency.APIToken
+	err := row.Scan(&token.ID, &token.UserID, &token.Name, &token.TokenHash, &token.CreatedAt, &token.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
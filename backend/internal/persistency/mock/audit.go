@@ -0,0 +1,150 @@
+package mock
+
+import (
+	"encoding/json"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// anonymousActor is recorded for changes made without an actor, like the Dal does
+const anonymousActor = "anonymous"
+
+// WithActor returns a mock sharing the same tables and tenant that records its changes as made by actor
+func (d *DalMock) WithActor(actor string) persistency.DalInterface {
+	return &DalMock{
+		tables:   d.tables,
+		tenantID: d.tenantID,
+		actor:    actor,
+	}
+}
+
+// audited runs mutate and records the change it made to one entity in the audit log
+func (d *DalMock) audited(entityType contracts.AuditEntityType, entityID string, action contracts.AuditAction, mutate func() error) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	before := d.snapshotEntity(entityType, entityID)
+	if err := mutate(); err != nil {
+		return err
+	}
+
+	d.writeAudit(entityType, entityID, action, before)
+	return nil
+}
+
+// writeAudit records a change, a change leaving no entity before or after it changed nothing
+func (d *DalMock) writeAudit(entityType contracts.AuditEntityType, entityID string, action contracts.AuditAction, before json.RawMessage) {
+	after := d.snapshotEntity(entityType, entityID)
+	if before == nil && after == nil {
+		return
+	}
+
+	actor := d.actor
+	if actor == "" {
+		actor = anonymousActor
+	}
+
+	d.AuditRecords = append(d.AuditRecords, &persistency.AuditRecord{
+		ID:         uuid.New().String(),
+		TenantID:   d.tenantID,
+		Actor:      actor,
+		CreatedAt:  time.Now().UTC(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+}
+
+// snapshotEntity returns the entity as JSON together with the rows that belong to it, or nil when it does
+// not exist. The password and token hashes are not marshalled.
+func (d *DalMock) snapshotEntity(entityType contracts.AuditEntityType, entityID string) json.RawMessage {
+	var entity any
+
+	switch entityType {
+	case contracts.AuditEntityFlower:
+		if flower, _ := d.GetFlower(entityID); flower != nil {
+			packingOptions, _ := d.GetFlowerPackingOptions(entityID)
+			entity = map[string]any{"flower": flower, "packing_options": packingOptions}
+		}
+	case contracts.AuditEntityProduct:
+		if product, _ := d.GetProduct(entityID); product != nil {
+			flowers, _ := d.GetFlowersFromProduct(entityID)
+			entity = map[string]any{"product": product, "flowers": flowers}
+		}
+	case contracts.AuditEntityEvent:
+		if event, _ := d.GetEvent(entityID); event != nil {
+			products, _ := d.GetProductsFromEvent(entityID)
+			entity = map[string]any{"event": event, "products": products}
+		}
+	case contracts.AuditEntitySupplier:
+		if supplier, _ := d.GetSupplier(entityID); supplier != nil {
+			entity = supplier
+		}
+	case contracts.AuditEntityCustomer:
+		if customer, _ := d.GetCustomer(entityID); customer != nil {
+			entity = customer
+		}
+	case contracts.AuditEntityPurchaseOrder:
+		if purchaseOrder, _ := d.GetPurchaseOrder(entityID); purchaseOrder != nil {
+			entity = purchaseOrder
+		}
+	case contracts.AuditEntityStockLot:
+		if lot := d.getStockLot(entityID); lot != nil {
+			remaining := 0
+			for _, e := range d.StockLedger {
+				if d.owns(e.TenantID) && e.LotID == entityID {
+					remaining += e.Quantity
+				}
+			}
+			entity = map[string]any{"stock_lot": lot, "remaining": remaining}
+		}
+	case contracts.AuditEntityQuote:
+		if quote, _ := d.GetQuote(entityID); quote != nil {
+			entity = quote
+		}
+	case contracts.AuditEntityUser:
+		if user, _ := d.GetUser(entityID); user != nil {
+			entity = user
+		}
+	case contracts.AuditEntityAPIToken:
+		for _, t := range d.APITokens {
+			if t.ID == entityID {
+				entity = t
+			}
+		}
+	}
+
+	if entity == nil {
+		return nil
+	}
+	snapshot, _ := json.Marshal(entity)
+	return snapshot
+}
+
+func (d *DalMock) GetAuditRecords(req *contracts.GetAuditRecordsRequest) ([]*persistency.AuditRecord, error) {
+	records := []*persistency.AuditRecord{}
+
+	for _, r := range d.AuditRecords {
+		if !d.owns(r.TenantID) {
+			continue
+		}
+
+		if req.EntityType != "" && r.EntityType != req.EntityType {
+			continue
+		}
+
+		if req.EntityID != "" && r.EntityID != req.EntityID {
+			continue
+		}
+
+		records = append(records, r)
+	}
+
+	return records, nil
+}
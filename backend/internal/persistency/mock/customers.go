@@ -0,0 +1,124 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (d *DalMock) CreateCustomer(customer *persistency.Customer) error {
+	if customer.ID == "" {
+		customer.ID = uuid.New().String()
+	}
+
+	return d.audited(contracts.AuditEntityCustomer, customer.ID, contracts.AuditActionCreate, func() error {
+		customer.TenantID = d.tenantID
+		d.Customers = append(d.Customers, customer)
+		return nil
+	})
+}
+
+func (d *DalMock) EditCustomer(customer *persistency.Customer) error {
+	return d.audited(contracts.AuditEntityCustomer, customer.ID, contracts.AuditActionEdit, func() error {
+		for i, c := range d.Customers {
+			if !d.owns(c.TenantID) {
+				continue
+			}
+
+			if c.ID == customer.ID {
+				customer.TenantID = d.tenantID
+				d.Customers[i] = customer
+				return nil
+			}
+		}
+
+		return persistency.NotFound("customer with ID %s does not exist", customer.ID)
+	})
+}
+
+func (d *DalMock) DeleteCustomer(id string) error {
+	return d.audited(contracts.AuditEntityCustomer, id, contracts.AuditActionDelete, func() error {
+		found := false
+		for i, c := range d.Customers {
+			if !d.owns(c.TenantID) {
+				continue
+			}
+
+			if c.ID == id {
+				d.Customers = append(d.Customers[:i], d.Customers[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return persistency.NotFound("customer with ID %s does not exist", id)
+		}
+
+		// events of a deleted customer keep their inline contact details
+		for _, e := range d.Events {
+			if !d.owns(e.TenantID) {
+				continue
+			}
+
+			if e.CustomerID == id {
+				e.CustomerID = ""
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *DalMock) GetFilteredCustomers(req *contracts.GetFilteredCustomersRequest) ([]*persistency.Customer, error) {
+	customers := []*persistency.Customer{}
+	search := strings.ToLower(req.Search)
+
+	for _, c := range d.Customers {
+		if !d.owns(c.TenantID) {
+			continue
+		}
+
+		if search != "" &&
+			!strings.Contains(strings.ToLower(c.Name), search) &&
+			!strings.Contains(strings.ToLower(c.Phone), search) &&
+			!strings.Contains(strings.ToLower(c.Email), search) {
+			continue
+		}
+
+		customers = append(customers, c)
+	}
+
+	return customers, nil
+}
+
+func (d *DalMock) GetCustomer(id string) (*persistency.Customer, error) {
+	for _, c := range d.Customers {
+		if !d.owns(c.TenantID) {
+			continue
+		}
+
+		if c.ID == id {
+			return c, nil
+		}
+	}
+
+	return nil, persistency.NotFound("customer with ID %s does not exist", id)
+}
+
+func (d *DalMock) GetCustomerEvents(customerID string) ([]*persistency.Event, error) {
+	events := []*persistency.Event{}
+
+	for _, e := range d.Events {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		if e.CustomerID == customerID && e.DeletedAt == nil {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,11 @@
+package mock
+
+import (
+	"context"
+	persistency "flower-management/internal/persistency/contracts"
+)
+
+// CheckHealth always succeeds, the mock has no database and no migrations to be pending
+func (d *DalMock) CheckHealth(ctx context.Context) (*persistency.Health, error) {
+	return &persistency.Health{}, nil
+}
@@ -3,215 +3,555 @@ package mock
 import (
 	"flower-management/contracts"
 	persistency "flower-management/internal/persistency/contracts"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
+// DalMock keeps the rows of every tenant in the shared tables and only sees those of its own tenant,
+// like the row level security of the Dal does. NewDalMock returns the mock of the default tenant, a mock
+// without a tenant sees no rows and changes nothing like the Dal.
 type DalMock struct {
-	Flowers  []*persistency.Flower
-	Products []*persistency.Product
-	Events   []*persistency.Event
+	*tables
+	tenantID string
+	actor    string
+}
+
+type tables struct {
+	Tenants        []*persistency.Tenant
+	Flowers        []*persistency.Flower
+	Products       []*persistency.Product
+	Events         []*persistency.Event
+	EventProducts  []*persistency.EventProduct
+	ProductFlowers []*persistency.FlowerInProduct
+	Suppliers      []*persistency.Supplier
+	Customers      []*persistency.Customer
+	PackingOptions []*persistency.FlowerPackageOptions
+	PurchaseOrders []*persistency.PurchaseOrder
+	StockLots      []*persistency.StockLot
+	StockLedger    []*persistency.StockEntry
+	Quotes         []*persistency.Quote
+	AuditRecords   []*persistency.AuditRecord
+	Users          []*persistency.User
+	APITokens      []*persistency.APIToken
 }
 
 func NewDalMock() persistency.DalInterface {
-	return &DalMock{
-		Flowers:  []*persistency.Flower{},
-		Products: []*persistency.Product{},
-		Events:   []*persistency.Event{},
-	}
+	return &DalMock{tenantID: persistency.DefaultTenantID, tables: &tables{
+		Tenants:        []*persistency.Tenant{},
+		Flowers:        []*persistency.Flower{},
+		Products:       []*persistency.Product{},
+		Events:         []*persistency.Event{},
+		EventProducts:  []*persistency.EventProduct{},
+		ProductFlowers: []*persistency.FlowerInProduct{},
+		Suppliers:      []*persistency.Supplier{{ID: persistency.DefaultSupplierID, TenantID: persistency.DefaultTenantID, Name: "Default supplier"}},
+		Customers:      []*persistency.Customer{},
+		PackingOptions: []*persistency.FlowerPackageOptions{},
+		PurchaseOrders: []*persistency.PurchaseOrder{},
+		StockLots:      []*persistency.StockLot{},
+		StockLedger:    []*persistency.StockEntry{},
+		Quotes:         []*persistency.Quote{},
+		AuditRecords:   []*persistency.AuditRecord{},
+		Users:          []*persistency.User{},
+		APITokens:      []*persistency.APIToken{},
+	}}
 }
 
 func (d *DalMock) CreateFlower(flower *persistency.Flower, packingOptions *[]contracts.PackingOptions) error {
-	d.Flowers = append(d.Flowers, flower)
-	return nil
+	if flower.ID == "" {
+		flower.ID = uuid.New().String()
+	}
+
+	return d.audited(contracts.AuditEntityFlower, flower.ID, contracts.AuditActionCreate, func() error {
+		flower.TenantID = d.tenantID
+		d.Flowers = append(d.Flowers, flower)
+
+		for _, packingOption := range *packingOptions {
+			supplierID := packingOption.SupplierID
+			if supplierID == "" {
+				supplierID = persistency.DefaultSupplierID
+			}
+			d.PackingOptions = append(d.PackingOptions, &persistency.FlowerPackageOptions{
+				FlowerID:     flower.ID,
+				SupplierID:   supplierID,
+				NumOfFlowers: packingOption.Quantity,
+				Price:        packingOption.Price,
+			})
+		}
+
+		return nil
+	})
 }
 
 func (d *DalMock) CreateProduct(product *persistency.Product) error {
-	d.Products = append(d.Products, product)
-	return nil
+	if product.ID == "" {
+		product.ID = uuid.New().String()
+	}
+
+	return d.audited(contracts.AuditEntityProduct, product.ID, contracts.AuditActionCreate, func() error {
+		product.TenantID = d.tenantID
+		d.Products = append(d.Products, product)
+		return nil
+	})
 }
 
 func (d *DalMock) CreateEvent(event *persistency.Event) error {
-	d.Events = append(d.Events, event)
-	return nil
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+
+	return d.audited(contracts.AuditEntityEvent, event.ID, contracts.AuditActionCreate, func() error {
+		event.TenantID = d.tenantID
+		d.Events = append(d.Events, event)
+		return nil
+	})
 }
 
 func (d *DalMock) EditFlower(flower *persistency.Flower) error {
-	for i, f := range d.Flowers {
-		if f.ID == flower.ID {
-			d.Flowers[i] = flower
-			return nil
+	return d.audited(contracts.AuditEntityFlower, flower.ID, contracts.AuditActionEdit, func() error {
+		for i, f := range d.Flowers {
+			if !d.owns(f.TenantID) {
+				continue
+			}
+
+			if f.ID == flower.ID {
+				flower.TenantID = d.tenantID
+				d.Flowers[i] = flower
+				return nil
+			}
 		}
-	}
 
-	return nil
+		return persistency.NotFound("flower with ID %s does not exist", flower.ID)
+	})
 }
 
 func (d *DalMock) EditProduct(product *persistency.Product) error {
-	for i, p := range d.Products {
-		if p.ID == product.ID {
-			d.Products[i] = product
-			return nil
+	return d.audited(contracts.AuditEntityProduct, product.ID, contracts.AuditActionEdit, func() error {
+		for i, p := range d.Products {
+			if !d.owns(p.TenantID) {
+				continue
+			}
+
+			if p.ID == product.ID {
+				product.TenantID = d.tenantID
+				d.Products[i] = product
+				return nil
+			}
 		}
-	}
 
-	return nil
+		return persistency.NotFound("product with ID %s does not exist", product.ID)
+	})
 }
 
 func (d *DalMock) EditEvent(event *persistency.Event) error {
-	for i, e := range d.Events {
-		if e.ID == event.ID {
-			d.Events[i] = event
-			return nil
+	return d.audited(contracts.AuditEntityEvent, event.ID, contracts.AuditActionEdit, func() error {
+		for i, e := range d.Events {
+			if !d.owns(e.TenantID) {
+				continue
+			}
+
+			if e.ID == event.ID {
+				// the status only changes through UpdateEventStatus
+				event.Status = e.Status
+				event.TenantID = d.tenantID
+				d.Events[i] = event
+				return nil
+			}
 		}
-	}
 
-	return nil
+		return persistency.NotFound("event with ID %s does not exist", event.ID)
+	})
 }
 
 func (d *DalMock) DeleteFlower(id string) error {
-	for i, f := range d.Flowers {
-		if f.ID == id {
-			d.Flowers = append(d.Flowers[:i], d.Flowers[i+1:]...)
-			return nil
+	return d.audited(contracts.AuditEntityFlower, id, contracts.AuditActionDelete, func() error {
+		for _, f := range d.Flowers {
+			if !d.owns(f.TenantID) {
+				continue
+			}
+
+			if f.ID == id && f.DeletedAt == nil {
+				deletedAt := time.Now().UTC()
+				f.DeletedAt = &deletedAt
+				return nil
+			}
 		}
-	}
 
-	return nil
+		return persistency.NotFound("flower with ID %s does not exist", id)
+	})
 }
 
 func (d *DalMock) DeleteProduct(id string) error {
-	for i, p := range d.Products {
-		if p.ID == id {
-			d.Products = append(d.Products[:i], d.Products[i+1:]...)
-			return nil
+	return d.audited(contracts.AuditEntityProduct, id, contracts.AuditActionDelete, func() error {
+		for _, p := range d.Products {
+			if !d.owns(p.TenantID) {
+				continue
+			}
+
+			if p.ID == id && p.DeletedAt == nil {
+				deletedAt := time.Now().UTC()
+				p.DeletedAt = &deletedAt
+				return nil
+			}
 		}
-	}
 
-	return nil
+		return persistency.NotFound("product with ID %s does not exist", id)
+	})
 }
 
 func (d *DalMock) DeleteEvent(id string) error {
-	for i, e := range d.Events {
-		if e.ID == id {
-			d.Events = append(d.Events[:i], d.Events[i+1:]...)
-			return nil
+	return d.audited(contracts.AuditEntityEvent, id, contracts.AuditActionDelete, func() error {
+		for _, e := range d.Events {
+			if !d.owns(e.TenantID) {
+				continue
+			}
+
+			if e.ID == id && e.DeletedAt == nil {
+				deletedAt := time.Now().UTC()
+				e.DeletedAt = &deletedAt
+				return nil
+			}
 		}
-	}
 
-	return nil
+		return persistency.NotFound("event with ID %s does not exist", id)
+	})
 }
 
-func (d *DalMock) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, error) {
+func (d *DalMock) GetFilteredFlowers(req *contracts.GetFilteredFlowersRequest) ([]*persistency.Flower, *persistency.Page, error) {
 	flowers := []*persistency.Flower{}
 
 	for _, f := range d.Flowers {
-		if req.Name != "" && f.Name != req.Name {
+		if !d.owns(f.TenantID) {
+			continue
+		}
+
+		if len(req.IDs) > 0 && !slices.Contains(req.IDs, f.ID) {
+			continue
+		}
+
+		if !matchText(f.Name, req.Name, req.Match) {
+			continue
+		}
+
+		if !req.IncludeDeleted && f.DeletedAt != nil {
 			continue
 		}
 
 		flowers = append(flowers, f)
 	}
 
-	return flowers, nil
+	return paginate(flowers, &req.Page, persistency.FlowerSortFields)
 }
 
-func (d *DalMock) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, error) {
+func (d *DalMock) GetFilteredProducts(req *contracts.GetFilteredProductsRequest) ([]*persistency.Product, *persistency.Page, error) {
 	products := []*persistency.Product{}
 
 	for _, p := range d.Products {
-		if req.Name != "" && p.Name != req.Name {
+		if !d.owns(p.TenantID) {
 			continue
 		}
 
-		if req.Description != "" && p.Description != req.Description {
+		if len(req.IDs) > 0 && !slices.Contains(req.IDs, p.ID) {
+			continue
+		}
+
+		if !matchText(p.Name, req.Name, req.Match) {
+			continue
+		}
+
+		if !matchText(p.Description, req.Description, req.Match) {
+			continue
+		}
+
+		if !req.IncludeDeleted && p.DeletedAt != nil {
 			continue
 		}
 
 		products = append(products, p)
 	}
 
-	return products, nil
+	return paginate(products, &req.Page, persistency.ProductSortFields)
 }
 
-func (d *DalMock) GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*persistency.Event, error) {
+func (d *DalMock) GetFilteredEvents(req *contracts.GetFilteredEventsRequest) ([]*persistency.Event, *persistency.Page, error) {
 	events := []*persistency.Event{}
 
 	for _, e := range d.Events {
-		if req.Name != "" && e.Name != req.Name {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		if len(req.IDs) > 0 && !slices.Contains(req.IDs, e.ID) {
+			continue
+		}
+
+		if !matchText(e.Name, req.Name, req.Match) {
+			continue
+		}
+
+		if !matchText(e.Address, req.Address, req.Match) {
+			continue
+		}
+
+		if !matchText(e.Description, req.Description, req.Match) {
+			continue
+		}
+
+		if !req.Date.IsZero() && (e.Date.Before(req.Date) || !e.Date.Before(req.Date.AddDate(0, 0, 1))) {
 			continue
 		}
 
-		if !req.Date.IsZero() && e.Date != req.Date {
+		if !req.DateFrom.IsZero() && e.Date.Before(req.DateFrom) {
 			continue
 		}
 
-		if req.Address != "" && e.Address != req.Address {
+		if !req.DateTo.IsZero() && !e.Date.Before(req.DateTo) {
 			continue
 		}
 
-		if req.Description != "" && e.Description != req.Description {
+		if len(req.CustomerIDs) > 0 && !slices.Contains(req.CustomerIDs, e.CustomerID) {
+			continue
+		}
+
+		if len(req.Statuses) > 0 && !slices.Contains(req.Statuses, e.Status) {
+			continue
+		}
+
+		if !req.IncludeDeleted && e.DeletedAt != nil {
 			continue
 		}
 
 		events = append(events, e)
 	}
 
-	return events, nil
+	return paginate(events, &req.Page, persistency.EventSortFields)
+}
+
+// matchText compares a value with a text filter ignoring case, like the Dal does
+func matchText(value, filter string, mode contracts.MatchMode) bool {
+	if filter == "" {
+		return true
+	}
+
+	value = strings.ToLower(value)
+	filter = strings.ToLower(filter)
+	switch mode {
+	case contracts.MatchExact:
+		return value == filter
+	case contracts.MatchPrefix:
+		return strings.HasPrefix(value, filter)
+	}
+	return strings.Contains(value, filter)
 }
 
 func (d *DalMock) GetEvent(id string) (*persistency.Event, error) {
 	for _, e := range d.Events {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
 		if e.ID == id {
 			return e, nil
 		}
 	}
 
-	return nil, nil
+	return nil, persistency.NotFound("event with ID %s does not exist", id)
 }
 
 func (d *DalMock) GetProduct(id string) (*persistency.Product, error) {
 	for _, p := range d.Products {
+		if !d.owns(p.TenantID) {
+			continue
+		}
+
 		if p.ID == id {
 			return p, nil
 		}
 	}
 
-	return nil, nil
+	return nil, persistency.NotFound("product with ID %s does not exist", id)
 }
 
 func (d *DalMock) GetFlower(id string) (*persistency.Flower, error) {
 	for _, f := range d.Flowers {
+		if !d.owns(f.TenantID) {
+			continue
+		}
+
 		if f.ID == id {
 			return f, nil
 		}
 	}
 
-	return nil, nil
+	return nil, persistency.NotFound("flower with ID %s does not exist", id)
 }
 
 func (d *DalMock) AddFlowersToProduct(req *contracts.AddFlowersToProductRequest) error {
-	return nil
+	return d.audited(contracts.AuditEntityProduct, req.ProductID, contracts.AuditActionEdit, func() error {
+		for _, flower := range *req.Flowers {
+			d.ProductFlowers = append(d.ProductFlowers, &persistency.FlowerInProduct{
+				FlowerID:     flower.FlowerID,
+				ProductID:    req.ProductID,
+				NumOfFlowers: flower.NumOfFlowers,
+			})
+		}
+
+		return nil
+	})
 }
 
 func (d *DalMock) AddProductsToEvent(req *contracts.AddProductsToEventRequest) error {
-	return nil
+	return d.audited(contracts.AuditEntityEvent, req.EventID, contracts.AuditActionEdit, func() error {
+		for _, product := range *req.Products {
+			d.EventProducts = append(d.EventProducts, &persistency.EventProduct{
+				EventID:   req.EventID,
+				ProductID: product.ProductID,
+				Quantity:  product.Quantity,
+			})
+		}
+
+		return nil
+	})
 }
 
 func (d *DalMock) EditFlowersInProduct(req *contracts.AddFlowersToProductRequest) error {
-	return nil
+	return d.audited(contracts.AuditEntityProduct, req.ProductID, contracts.AuditActionEdit, func() error {
+		for _, flower := range *req.Flowers {
+			for _, f := range d.ProductFlowers {
+				if f.ProductID == req.ProductID && f.FlowerID == flower.FlowerID {
+					f.NumOfFlowers = flower.NumOfFlowers
+				}
+			}
+		}
+
+		return nil
+	})
 }
 
 func (d *DalMock) EditProductsInEvent(req *contracts.AddProductsToEventRequest) error {
-	return nil
+	return d.audited(contracts.AuditEntityEvent, req.EventID, contracts.AuditActionEdit, func() error {
+		for _, product := range *req.Products {
+			for _, p := range d.EventProducts {
+				if p.EventID == req.EventID && p.ProductID == product.ProductID {
+					p.Quantity = product.Quantity
+				}
+			}
+		}
+
+		return nil
+	})
 }
 
 func (d *DalMock) GetProductsFromEvent(eventID string) ([]*persistency.EventProduct, error) {
-	return nil, nil
+	return d.GetProductsFromEvents([]string{eventID})
+}
+
+// GetProductsFromEvents only returns the products of the events of the tenant, like the Dal
+func (d *DalMock) GetProductsFromEvents(eventIDs []string) ([]*persistency.EventProduct, error) {
+	var productsInEvents []*persistency.EventProduct
+
+	for _, p := range d.EventProducts {
+		if !slices.Contains(eventIDs, p.EventID) {
+			continue
+		}
+		if event, _ := d.GetEvent(p.EventID); event != nil {
+			productsInEvents = append(productsInEvents, p)
+		}
+	}
+
+	return productsInEvents, nil
+}
+
+func (d *DalMock) UpdateEventStatus(id string, status contracts.EventStatus) error {
+	return d.audited(contracts.AuditEntityEvent, id, contracts.AuditActionEdit, func() error {
+		for _, e := range d.Events {
+			if !d.owns(e.TenantID) {
+				continue
+			}
+
+			if e.ID == id {
+				e.Status = status
+				return nil
+			}
+		}
+
+		return persistency.NotFound("event with ID %s does not exist", id)
+	})
 }
 
 func (d *DalMock) GetFlowersFromProduct(productID string) ([]*persistency.FlowerInProduct, error) {
-	return nil, nil
+	return d.GetFlowersFromProducts([]string{productID})
+}
+
+// GetFlowersFromProducts only returns the flowers of the products of the tenant, like the Dal
+func (d *DalMock) GetFlowersFromProducts(productIDs []string) ([]*persistency.FlowerInProduct, error) {
+	var flowersInProducts []*persistency.FlowerInProduct
+
+	for _, f := range d.ProductFlowers {
+		if !slices.Contains(productIDs, f.ProductID) {
+			continue
+		}
+		if product, _ := d.GetProduct(f.ProductID); product != nil {
+			flowersInProducts = append(flowersInProducts, f)
+		}
+	}
+
+	return flowersInProducts, nil
+}
+
+func (d *DalMock) GetFlowersPackingOptions(flowerIDs []string) ([]*persistency.FlowerPackageOptions, error) {
+	var packingOptions []*persistency.FlowerPackageOptions
+
+	for _, flowerID := range flowerIDs {
+		flowerPackingOptions, err := d.GetFlowerPackingOptions(flowerID)
+		if err != nil {
+			return nil, err
+		}
+		packingOptions = append(packingOptions, flowerPackingOptions...)
+	}
+
+	return packingOptions, nil
 }
 
 func (d *DalMock) GetFlowerPackingOptions(flowerID string) ([]*persistency.FlowerPackageOptions, error) {
-	return nil, nil
+	packingOptions := []*persistency.FlowerPackageOptions{}
+
+	// packing options belong to the tenant of their flower
+	if flower, _ := d.GetFlower(flowerID); flower == nil {
+		return packingOptions, nil
+	}
+
+	for _, o := range d.PackingOptions {
+		if o.FlowerID == flowerID {
+			packingOptions = append(packingOptions, o)
+		}
+	}
+
+	return packingOptions, nil
+}
+
+func (d *DalMock) SetFlowerPackingOptions(req *contracts.SetFlowerPackingOptionsRequest) error {
+	return d.audited(contracts.AuditEntityFlower, req.FlowerID, contracts.AuditActionEdit, func() error {
+		packingOptions := []*persistency.FlowerPackageOptions{}
+
+		for _, o := range d.PackingOptions {
+			if o.FlowerID != req.FlowerID || o.SupplierID != req.SupplierID {
+				packingOptions = append(packingOptions, o)
+			}
+		}
+
+		for _, packingOption := range *req.PackingOptions {
+			packingOptions = append(packingOptions, &persistency.FlowerPackageOptions{
+				FlowerID:     req.FlowerID,
+				SupplierID:   req.SupplierID,
+				NumOfFlowers: packingOption.Quantity,
+				Price:        packingOption.Price,
+			})
+		}
+		d.PackingOptions = packingOptions
+
+		return nil
+	})
 }
@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"sort"
+)
+
+// paginate sorts the filtered rows and cuts the requested page out of them, the same way the
+// keyset pagination of the Dal does.
+func paginate[T persistency.Sortable](rows []T, page *contracts.PageRequest, fields map[string]persistency.SortKind) ([]T, *persistency.Page, error) {
+	sortFields, err := persistency.SortWithID(page.Sort, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compareRow := func(row T, values []string) int {
+		for i, sortField := range sortFields {
+			result := persistency.CompareSortValues(fields[sortField.Field], row.SortValue(sortField.Field), values[i])
+			if sortField.Descending {
+				result = -result
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+	sortValues := func(row T) []string {
+		values := make([]string, len(sortFields))
+		for i, sortField := range sortFields {
+			values[i] = row.SortValue(sortField.Field)
+		}
+		return values
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compareRow(rows[i], sortValues(rows[j])) < 0
+	})
+
+	result := &persistency.Page{Total: len(rows)}
+	if page.Cursor != "" {
+		cursorValues, err := persistency.DecodeCursor(page.Cursor, sortFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		for len(rows) > 0 && compareRow(rows[0], cursorValues) <= 0 {
+			rows = rows[1:]
+		}
+	}
+
+	if page.Limit > 0 && len(rows) > page.Limit {
+		rows = rows[:page.Limit]
+		result.NextCursor = persistency.NewCursor(rows[len(rows)-1], sortFields)
+	}
+
+	return rows, result, nil
+}
@@ -0,0 +1,110 @@
+package mock
+
+import (
+	"encoding/json"
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (d *DalMock) CreatePurchaseOrders(eventID string, purchaseOrders []*persistency.PurchaseOrder) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	// draft orders of the event are replaced
+	kept := []*persistency.PurchaseOrder{}
+	replaced := []string{}
+	befores := []json.RawMessage{}
+	for _, p := range d.PurchaseOrders {
+		if !d.owns(p.TenantID) || p.EventID != eventID || p.Status != contracts.PurchaseOrderStatusDraft {
+			kept = append(kept, p)
+			continue
+		}
+		replaced = append(replaced, p.ID)
+		befores = append(befores, d.snapshotEntity(contracts.AuditEntityPurchaseOrder, p.ID))
+	}
+
+	now := time.Now().UTC()
+	for _, purchaseOrder := range purchaseOrders {
+		purchaseOrder.ID = uuid.New().String()
+		purchaseOrder.TenantID = d.tenantID
+		purchaseOrder.EventID = eventID
+		purchaseOrder.CreatedAt = now
+		purchaseOrder.UpdatedAt = now
+		for _, line := range purchaseOrder.Lines {
+			line.PurchaseOrderID = purchaseOrder.ID
+		}
+		kept = append(kept, purchaseOrder)
+	}
+	d.PurchaseOrders = kept
+
+	for i, id := range replaced {
+		d.writeAudit(contracts.AuditEntityPurchaseOrder, id, contracts.AuditActionDelete, befores[i])
+	}
+	for _, purchaseOrder := range purchaseOrders {
+		d.writeAudit(contracts.AuditEntityPurchaseOrder, purchaseOrder.ID, contracts.AuditActionCreate, nil)
+	}
+
+	return nil
+}
+
+func (d *DalMock) GetPurchaseOrder(id string) (*persistency.PurchaseOrder, error) {
+	for _, p := range d.PurchaseOrders {
+		if !d.owns(p.TenantID) {
+			continue
+		}
+
+		if p.ID == id {
+			return p, nil
+		}
+	}
+
+	return nil, persistency.NotFound("purchase order with ID %s does not exist", id)
+}
+
+func (d *DalMock) GetFilteredPurchaseOrders(req *contracts.GetFilteredPurchaseOrdersRequest) ([]*persistency.PurchaseOrder, error) {
+	purchaseOrders := []*persistency.PurchaseOrder{}
+
+	for _, p := range d.PurchaseOrders {
+		if !d.owns(p.TenantID) {
+			continue
+		}
+
+		if req.EventID != "" && p.EventID != req.EventID {
+			continue
+		}
+
+		if req.SupplierID != "" && p.SupplierID != req.SupplierID {
+			continue
+		}
+
+		if req.Status != "" && p.Status != req.Status {
+			continue
+		}
+
+		purchaseOrders = append(purchaseOrders, p)
+	}
+
+	return purchaseOrders, nil
+}
+
+func (d *DalMock) UpdatePurchaseOrderStatus(id string, status contracts.PurchaseOrderStatus) error {
+	return d.audited(contracts.AuditEntityPurchaseOrder, id, contracts.AuditActionEdit, func() error {
+		for _, p := range d.PurchaseOrders {
+			if !d.owns(p.TenantID) {
+				continue
+			}
+
+			if p.ID == id {
+				p.Status = status
+				p.UpdatedAt = time.Now().UTC()
+				return nil
+			}
+		}
+
+		return persistency.NotFound("purchase order with ID %s does not exist", id)
+	})
+}
@@ -0,0 +1,135 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"slices"
+	"time"
+)
+
+func (d *DalMock) RestoreFlower(id string) error {
+	return d.audited(contracts.AuditEntityFlower, id, contracts.AuditActionRestore, func() error {
+		for _, f := range d.Flowers {
+			if !d.owns(f.TenantID) {
+				continue
+			}
+
+			if f.ID == id {
+				f.DeletedAt = nil
+				return nil
+			}
+		}
+
+		return persistency.NotFound("deleted flower with ID %s does not exist", id)
+	})
+}
+
+func (d *DalMock) RestoreProduct(id string) error {
+	return d.audited(contracts.AuditEntityProduct, id, contracts.AuditActionRestore, func() error {
+		for _, p := range d.Products {
+			if !d.owns(p.TenantID) {
+				continue
+			}
+
+			if p.ID == id {
+				p.DeletedAt = nil
+				return nil
+			}
+		}
+
+		return persistency.NotFound("deleted product with ID %s does not exist", id)
+	})
+}
+
+func (d *DalMock) RestoreEvent(id string) error {
+	return d.audited(contracts.AuditEntityEvent, id, contracts.AuditActionRestore, func() error {
+		for _, e := range d.Events {
+			if !d.owns(e.TenantID) {
+				continue
+			}
+
+			if e.ID == id {
+				e.DeletedAt = nil
+				return nil
+			}
+		}
+
+		return persistency.NotFound("deleted event with ID %s does not exist", id)
+	})
+}
+
+// PurgeDeleted removes the tombstones of the tenant deleted before the given time together with the rows
+// that belong to them, and keeps the products and flowers still referenced, like the Dal
+func (d *DalMock) PurgeDeleted(before time.Time) (int, error) {
+	purged := 0
+	purge := func(entityType contracts.AuditEntityType, id string, remove func()) {
+		snapshot := d.snapshotEntity(entityType, id)
+		remove()
+		d.writeAudit(entityType, id, contracts.AuditActionPurge, snapshot)
+		purged++
+	}
+
+	for _, e := range slices.Clone(d.Events) {
+		if d.owns(e.TenantID) && e.DeletedAt != nil && e.DeletedAt.Before(before) {
+			purge(contracts.AuditEntityEvent, e.ID, func() { d.removeEvent(e) })
+		}
+	}
+
+	for _, p := range slices.Clone(d.Products) {
+		if d.owns(p.TenantID) && p.DeletedAt != nil && p.DeletedAt.Before(before) && !d.productReferenced(p.ID) {
+			purge(contracts.AuditEntityProduct, p.ID, func() { d.removeProduct(p) })
+		}
+	}
+
+	for _, f := range slices.Clone(d.Flowers) {
+		if d.owns(f.TenantID) && f.DeletedAt != nil && f.DeletedAt.Before(before) && !d.flowerReferenced(f.ID) {
+			purge(contracts.AuditEntityFlower, f.ID, func() { d.removeFlower(f) })
+		}
+	}
+
+	return purged, nil
+}
+
+// removeEvent removes an event with its products, purchase orders and quotes, its stock entries are kept
+func (d *DalMock) removeEvent(event *persistency.Event) {
+	d.Events = slices.DeleteFunc(d.Events, func(e *persistency.Event) bool { return e == event })
+	d.EventProducts = slices.DeleteFunc(d.EventProducts, func(p *persistency.EventProduct) bool { return p.EventID == event.ID })
+	d.PurchaseOrders = slices.DeleteFunc(d.PurchaseOrders, func(p *persistency.PurchaseOrder) bool { return p.EventID == event.ID })
+	d.Quotes = slices.DeleteFunc(d.Quotes, func(q *persistency.Quote) bool { return q.EventID == event.ID })
+	for _, e := range d.StockLedger {
+		if e.EventID == event.ID {
+			e.EventID = ""
+		}
+	}
+}
+
+func (d *DalMock) removeProduct(product *persistency.Product) {
+	d.Products = slices.DeleteFunc(d.Products, func(p *persistency.Product) bool { return p == product })
+	d.ProductFlowers = slices.DeleteFunc(d.ProductFlowers, func(f *persistency.FlowerInProduct) bool { return f.ProductID == product.ID })
+}
+
+func (d *DalMock) removeFlower(flower *persistency.Flower) {
+	d.Flowers = slices.DeleteFunc(d.Flowers, func(f *persistency.Flower) bool { return f == flower })
+	d.PackingOptions = slices.DeleteFunc(d.PackingOptions, func(o *persistency.FlowerPackageOptions) bool { return o.FlowerID == flower.ID })
+}
+
+// productReferenced reports whether an event still holds the product
+func (d *DalMock) productReferenced(productID string) bool {
+	return slices.ContainsFunc(d.EventProducts, func(p *persistency.EventProduct) bool { return p.ProductID == productID })
+}
+
+// flowerReferenced reports whether a product, a purchase order or a stock lot still holds the flower
+func (d *DalMock) flowerReferenced(flowerID string) bool {
+	if slices.ContainsFunc(d.ProductFlowers, func(f *persistency.FlowerInProduct) bool { return f.FlowerID == flowerID }) {
+		return true
+	}
+	if slices.ContainsFunc(d.StockLots, func(l *persistency.StockLot) bool { return l.FlowerID == flowerID }) {
+		return true
+	}
+	for _, p := range d.PurchaseOrders {
+		if slices.ContainsFunc(p.Lines, func(l *persistency.PurchaseOrderLine) bool { return l.FlowerID == flowerID }) {
+			return true
+		}
+	}
+	return false
+}
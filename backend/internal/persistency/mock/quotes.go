@@ -0,0 +1,101 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (d *DalMock) CreateQuote(quote *persistency.Quote) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	version := 0
+	for _, q := range d.Quotes {
+		if !d.owns(q.TenantID) {
+			continue
+		}
+
+		if q.EventID == quote.EventID && q.Version > version {
+			version = q.Version
+		}
+	}
+
+	quote.ID = uuid.New().String()
+	quote.TenantID = d.tenantID
+	quote.Version = version + 1
+	quote.CreatedAt = time.Now().UTC()
+	quote.UpdatedAt = quote.CreatedAt
+	for _, lineItem := range quote.LineItems {
+		lineItem.QuoteID = quote.ID
+	}
+	d.Quotes = append(d.Quotes, quote)
+	d.writeAudit(contracts.AuditEntityQuote, quote.ID, contracts.AuditActionCreate, nil)
+
+	return nil
+}
+
+func (d *DalMock) GetQuote(id string) (*persistency.Quote, error) {
+	for _, q := range d.Quotes {
+		if !d.owns(q.TenantID) {
+			continue
+		}
+
+		if q.ID == id {
+			return q, nil
+		}
+	}
+
+	return nil, persistency.NotFound("quote with ID %s does not exist", id)
+}
+
+func (d *DalMock) GetQuoteByVersion(eventID string, version int) (*persistency.Quote, error) {
+	for _, q := range d.Quotes {
+		if !d.owns(q.TenantID) {
+			continue
+		}
+
+		if q.EventID == eventID && q.Version == version {
+			return q, nil
+		}
+	}
+
+	return nil, persistency.NotFound("quote version %d of event %s does not exist", version, eventID)
+}
+
+func (d *DalMock) GetEventQuotes(eventID string) ([]*persistency.Quote, error) {
+	quotes := []*persistency.Quote{}
+
+	for _, q := range d.Quotes {
+		if !d.owns(q.TenantID) {
+			continue
+		}
+
+		if q.EventID == eventID {
+			quotes = append(quotes, q)
+		}
+	}
+
+	return quotes, nil
+}
+
+func (d *DalMock) UpdateQuoteStatus(id string, status contracts.QuoteStatus) error {
+	return d.audited(contracts.AuditEntityQuote, id, contracts.AuditActionEdit, func() error {
+		for _, q := range d.Quotes {
+			if !d.owns(q.TenantID) {
+				continue
+			}
+
+			if q.ID == id {
+				q.Status = status
+				q.UpdatedAt = time.Now().UTC()
+				return nil
+			}
+		}
+
+		return persistency.NotFound("quote with ID %s does not exist", id)
+	})
+}
@@ -0,0 +1,113 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"html"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// searchToken matches the words of a text, like the 'simple' configuration of the Dal they are compared
+// whole and ignoring case
+var searchToken = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// Search matches rows holding every word of the query, ranked by how often the words occur. It
+// does not parse the query syntax the Dal supports.
+func (d *DalMock) Search(req *contracts.SearchRequest) ([]*persistency.SearchHit, error) {
+	words := searchToken.FindAllString(strings.ToLower(req.Query), -1)
+	if len(words) == 0 {
+		return []*persistency.SearchHit{}, nil
+	}
+
+	var flowers, products, events []*persistency.SearchHit
+	for _, f := range d.Flowers {
+		if !d.owns(f.TenantID) {
+			continue
+		}
+
+		if f.DeletedAt == nil {
+			flowers = appendSearchHit(flowers, contracts.SearchEntityFlower, f.ID, f.Name, f.Name, words)
+		}
+	}
+	for _, p := range d.Products {
+		if !d.owns(p.TenantID) {
+			continue
+		}
+
+		if p.DeletedAt == nil {
+			products = appendSearchHit(products, contracts.SearchEntityProduct, p.ID, p.Name, joinNonEmpty(p.Name, p.Description), words)
+		}
+	}
+	for _, e := range d.Events {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		if e.DeletedAt == nil {
+			events = appendSearchHit(events, contracts.SearchEntityEvent, e.ID, e.Name, joinNonEmpty(e.Name, e.Description, e.Address), words)
+		}
+	}
+
+	hits := []*persistency.SearchHit{}
+	for _, group := range [][]*persistency.SearchHit{flowers, products, events} {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Rank > group[j].Rank
+		})
+		if req.Limit > 0 && len(group) > req.Limit {
+			group = group[:req.Limit]
+		}
+		hits = append(hits, group...)
+	}
+
+	return hits, nil
+}
+
+func appendSearchHit(hits []*persistency.SearchHit, entityType contracts.SearchEntityType, id, title, text string, words []string) []*persistency.SearchHit {
+	tokens := searchToken.FindAllStringIndex(text, -1)
+	counts := make(map[string]int)
+	for _, token := range tokens {
+		counts[strings.ToLower(text[token[0]:token[1]])]++
+	}
+
+	rank := 0
+	for _, word := range words {
+		if counts[word] == 0 {
+			return hits
+		}
+		rank += counts[word]
+	}
+
+	// the snippet is escaped like the one of the Dal, only the marks are markup
+	var snippet strings.Builder
+	last := 0
+	for _, token := range tokens {
+		if !slices.Contains(words, strings.ToLower(text[token[0]:token[1]])) {
+			continue
+		}
+		snippet.WriteString(html.EscapeString(text[last:token[0]]))
+		snippet.WriteString("<mark>" + html.EscapeString(text[token[0]:token[1]]) + "</mark>")
+		last = token[1]
+	}
+	snippet.WriteString(html.EscapeString(text[last:]))
+
+	return append(hits, &persistency.SearchHit{
+		EntityType: entityType,
+		ID:         id,
+		Title:      title,
+		Snippet:    snippet.String(),
+		Rank:       float64(rank),
+	})
+}
+
+func joinNonEmpty(values ...string) string {
+	nonEmpty := []string{}
+	for _, value := range values {
+		if value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+	return strings.Join(nonEmpty, " - ")
+}
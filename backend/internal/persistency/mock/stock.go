@@ -0,0 +1,200 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (d *DalMock) CreateStockLot(lot *persistency.StockLot, receipt *persistency.StockEntry) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	lot.ID = uuid.New().String()
+	lot.TenantID = d.tenantID
+	d.StockLots = append(d.StockLots, lot)
+
+	receipt.LotID = lot.ID
+	receipt.ID = uuid.New().String()
+	receipt.TenantID = d.tenantID
+	receipt.CreatedAt = time.Now().UTC()
+	d.StockLedger = append(d.StockLedger, receipt)
+	lot.Remaining = lot.Quantity
+	d.writeAudit(contracts.AuditEntityStockLot, lot.ID, contracts.AuditActionCreate, nil)
+
+	return nil
+}
+
+func (d *DalMock) AddStockEntries(entries []*persistency.StockEntry) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	remaining := make(map[string]int)
+	for _, e := range d.StockLedger {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		remaining[e.LotID] += e.Quantity
+	}
+
+	for _, entry := range entries {
+		lot := d.getStockLot(entry.LotID)
+		if lot == nil {
+			return persistency.NotFound("stock lot with ID %s does not exist", entry.LotID)
+		}
+		if lot.FlowerID != entry.FlowerID {
+			return persistency.Invalid("stock lot with ID %s does not hold flower %s", entry.LotID, entry.FlowerID)
+		}
+		if remaining[entry.LotID]+entry.Quantity < 0 {
+			return persistency.Conflict("not enough stock in lot %s: %d remaining, %d requested", entry.LotID, remaining[entry.LotID], -entry.Quantity)
+		}
+		remaining[entry.LotID] += entry.Quantity
+	}
+
+	for _, entry := range entries {
+		before := d.snapshotEntity(contracts.AuditEntityStockLot, entry.LotID)
+		entry.ID = uuid.New().String()
+		entry.TenantID = d.tenantID
+		entry.CreatedAt = time.Now().UTC()
+		d.StockLedger = append(d.StockLedger, entry)
+		d.writeAudit(contracts.AuditEntityStockLot, entry.LotID, contracts.AuditActionEdit, before)
+	}
+
+	return nil
+}
+
+func (d *DalMock) GetStockEntries(req *contracts.GetStockEntriesRequest) ([]*persistency.StockEntry, error) {
+	entries := []*persistency.StockEntry{}
+
+	for _, e := range d.StockLedger {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		if req.FlowerID != "" && e.FlowerID != req.FlowerID {
+			continue
+		}
+
+		if req.EventID != "" && e.EventID != req.EventID {
+			continue
+		}
+
+		if req.LotID != "" && e.LotID != req.LotID {
+			continue
+		}
+
+		if req.EntryType != "" && e.EntryType != req.EntryType {
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func (d *DalMock) GetStockLevels(flowerIDs []string) ([]*persistency.StockLevel, error) {
+	wanted := make(map[string]bool, len(flowerIDs))
+	for _, flowerID := range flowerIDs {
+		wanted[flowerID] = true
+	}
+
+	onHand := make(map[string]int)
+	for _, e := range d.StockLedger {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		if len(wanted) > 0 && !wanted[e.FlowerID] {
+			continue
+		}
+		onHand[e.FlowerID] += e.Quantity
+	}
+
+	levels := []*persistency.StockLevel{}
+	for flowerID, quantity := range onHand {
+		levels = append(levels, &persistency.StockLevel{FlowerID: flowerID, OnHand: quantity})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].FlowerID < levels[j].FlowerID
+	})
+
+	return levels, nil
+}
+
+func (d *DalMock) GetStockLots(req *contracts.GetStockLotsRequest) ([]*persistency.StockLot, error) {
+	wanted := make(map[string]bool, len(req.FlowerIDs))
+	for _, flowerID := range req.FlowerIDs {
+		wanted[flowerID] = true
+	}
+
+	remaining := make(map[string]int)
+	for _, e := range d.StockLedger {
+		if !d.owns(e.TenantID) {
+			continue
+		}
+
+		remaining[e.LotID] += e.Quantity
+	}
+
+	lots := []*persistency.StockLot{}
+	for _, l := range d.StockLots {
+		if !d.owns(l.TenantID) {
+			continue
+		}
+
+		if len(wanted) > 0 && !wanted[l.FlowerID] {
+			continue
+		}
+
+		if !req.ExpiringBefore.IsZero() && (l.ExpiresAt == nil || !l.ExpiresAt.Before(req.ExpiringBefore)) {
+			continue
+		}
+
+		if !req.FreshAt.IsZero() && l.ExpiresAt != nil && l.ExpiresAt.Before(req.FreshAt) {
+			continue
+		}
+
+		if req.OnlyAvailable && remaining[l.ID] <= 0 {
+			continue
+		}
+
+		lot := *l
+		lot.Remaining = remaining[l.ID]
+		lots = append(lots, &lot)
+	}
+
+	// first-expiring-first, lots that never expire come last
+	sort.SliceStable(lots, func(i, j int) bool {
+		a, b := lots[i], lots[j]
+		if (a.ExpiresAt == nil) != (b.ExpiresAt == nil) {
+			return b.ExpiresAt == nil
+		}
+		if a.ExpiresAt != nil && !a.ExpiresAt.Equal(*b.ExpiresAt) {
+			return a.ExpiresAt.Before(*b.ExpiresAt)
+		}
+		return a.ReceivedAt.Before(b.ReceivedAt)
+	})
+
+	return lots, nil
+}
+
+func (d *DalMock) getStockLot(id string) *persistency.StockLot {
+	for _, l := range d.StockLots {
+		if !d.owns(l.TenantID) {
+			continue
+		}
+
+		if l.ID == id {
+			return l
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+
+	"github.com/google/uuid"
+)
+
+func (d *DalMock) CreateSupplier(supplier *persistency.Supplier) error {
+	if supplier.ID == "" {
+		supplier.ID = uuid.New().String()
+	}
+
+	return d.audited(contracts.AuditEntitySupplier, supplier.ID, contracts.AuditActionCreate, func() error {
+		supplier.TenantID = d.tenantID
+		d.Suppliers = append(d.Suppliers, supplier)
+		return nil
+	})
+}
+
+func (d *DalMock) EditSupplier(supplier *persistency.Supplier) error {
+	if supplier.ID == persistency.DefaultSupplierID {
+		return persistency.Conflict("the default supplier is shared by every tenant and cannot be changed")
+	}
+
+	return d.audited(contracts.AuditEntitySupplier, supplier.ID, contracts.AuditActionEdit, func() error {
+		for i, s := range d.Suppliers {
+			if !d.owns(s.TenantID) {
+				continue
+			}
+
+			if s.ID == supplier.ID {
+				supplier.TenantID = d.tenantID
+				d.Suppliers[i] = supplier
+				return nil
+			}
+		}
+
+		return persistency.NotFound("supplier with ID %s does not exist", supplier.ID)
+	})
+}
+
+func (d *DalMock) DeleteSupplier(id string) error {
+	if id == persistency.DefaultSupplierID {
+		return persistency.Conflict("the default supplier is shared by every tenant and cannot be changed")
+	}
+
+	return d.audited(contracts.AuditEntitySupplier, id, contracts.AuditActionDelete, func() error {
+		found := false
+		for i, s := range d.Suppliers {
+			if !d.owns(s.TenantID) {
+				continue
+			}
+
+			if s.ID == id {
+				d.Suppliers = append(d.Suppliers[:i], d.Suppliers[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return persistency.NotFound("supplier with ID %s does not exist", id)
+		}
+
+		// the packing options of a supplier are deleted with it
+		packingOptions := []*persistency.FlowerPackageOptions{}
+		for _, o := range d.PackingOptions {
+			if o.SupplierID != id {
+				packingOptions = append(packingOptions, o)
+			}
+		}
+		d.PackingOptions = packingOptions
+
+		return nil
+	})
+}
+
+func (d *DalMock) GetFilteredSuppliers(req *contracts.GetFilteredSuppliersRequest) ([]*persistency.Supplier, error) {
+	suppliers := []*persistency.Supplier{}
+
+	for _, s := range d.Suppliers {
+		if !d.sees(s) {
+			continue
+		}
+
+		if req.Name != "" && s.Name != req.Name {
+			continue
+		}
+
+		suppliers = append(suppliers, s)
+	}
+
+	return suppliers, nil
+}
+
+func (d *DalMock) GetSupplier(id string) (*persistency.Supplier, error) {
+	for _, s := range d.Suppliers {
+		if !d.sees(s) {
+			continue
+		}
+
+		if s.ID == id {
+			return s, nil
+		}
+	}
+
+	return nil, persistency.NotFound("supplier with ID %s does not exist", id)
+}
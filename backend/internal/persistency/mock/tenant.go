@@ -0,0 +1,58 @@
+package mock
+
+import (
+	persistency "flower-management/internal/persistency/contracts"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WithTenant returns a mock sharing the same tables that only sees and changes the rows of the tenant
+func (d *DalMock) WithTenant(tenantID string) persistency.DalInterface {
+	return &DalMock{
+		tables:   d.tables,
+		tenantID: tenantID,
+		actor:    d.actor,
+	}
+}
+
+func (d *DalMock) Close() {}
+
+// owns reports whether a row of the tenant is visible to the mock
+func (d *DalMock) owns(tenantID string) bool {
+	return tenantID == d.tenantID
+}
+
+// checkTenant refuses changes made without a tenant, the Dal fails to store their rows with an empty tenant
+func (d *DalMock) checkTenant() error {
+	if d.tenantID == "" {
+		return persistency.Invalid("changes are made in a tenant")
+	}
+	return nil
+}
+
+// sees reports whether a supplier is visible to the mock, the default supplier is shared by every tenant
+func (d *DalMock) sees(supplier *persistency.Supplier) bool {
+	return d.owns(supplier.TenantID) || supplier.ID == persistency.DefaultSupplierID
+}
+
+func (d *DalMock) CreateTenant(tenant *persistency.Tenant) error {
+	tenant.ID = uuid.New().String()
+	tenant.CreatedAt = time.Now().UTC()
+	d.Tenants = append(d.Tenants, tenant)
+	return nil
+}
+
+func (d *DalMock) GetTenant(id string) (*persistency.Tenant, error) {
+	for _, t := range d.Tenants {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+
+	return nil, persistency.NotFound("tenant with ID %s does not exist", id)
+}
+
+func (d *DalMock) GetTenants() ([]*persistency.Tenant, error) {
+	return append([]*persistency.Tenant{}, d.Tenants...), nil
+}
@@ -0,0 +1,124 @@
+package mock
+
+import (
+	"flower-management/contracts"
+	persistency "flower-management/internal/persistency/contracts"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (d *DalMock) CreateUser(user *persistency.User) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	user.ID = uuid.New().String()
+	user.TenantID = d.tenantID
+	user.CreatedAt = time.Now().UTC()
+	d.Users = append(d.Users, user)
+	d.writeAudit(contracts.AuditEntityUser, user.ID, contracts.AuditActionCreate, nil)
+	return nil
+}
+
+func (d *DalMock) GetUser(id string) (*persistency.User, error) {
+	for _, u := range d.Users {
+		if u.ID == id && d.seesUser(u) {
+			return u, nil
+		}
+	}
+
+	return nil, persistency.NotFound("user with ID %s does not exist", id)
+}
+
+func (d *DalMock) GetUserByUsername(username string) (*persistency.User, error) {
+	for _, u := range d.Users {
+		if u.Username == username && d.seesUser(u) {
+			return u, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (d *DalMock) GetUsers() ([]*persistency.User, error) {
+	users := []*persistency.User{}
+	for _, u := range d.Users {
+		if d.seesUser(u) {
+			users = append(users, u)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+
+	return users, nil
+}
+
+func (d *DalMock) SetUserRole(id string, role contracts.Role) error {
+	return d.audited(contracts.AuditEntityUser, id, contracts.AuditActionEdit, func() error {
+		for _, u := range d.Users {
+			if u.ID == id && d.seesUser(u) {
+				u.Role = role
+				return nil
+			}
+		}
+
+		return persistency.NotFound("user with ID %s does not exist", id)
+	})
+}
+
+func (d *DalMock) CreateAPIToken(token *persistency.APIToken) error {
+	if err := d.checkTenant(); err != nil {
+		return err
+	}
+
+	token.ID = uuid.New().String()
+	token.CreatedAt = time.Now().UTC()
+	d.APITokens = append(d.APITokens, token)
+	d.writeAudit(contracts.AuditEntityAPIToken, token.ID, contracts.AuditActionCreate, nil)
+	return nil
+}
+
+func (d *DalMock) GetAPITokenByHash(tokenHash string) (*persistency.APIToken, error) {
+	for _, t := range d.APITokens {
+		if t.TokenHash == tokenHash {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (d *DalMock) GetUserAPITokens(userID string) ([]*persistency.APIToken, error) {
+	tokens := []*persistency.APIToken{}
+
+	for _, t := range d.APITokens {
+		if t.UserID == userID {
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (d *DalMock) RevokeAPIToken(id string) error {
+	return d.audited(contracts.AuditEntityAPIToken, id, contracts.AuditActionEdit, func() error {
+		for _, t := range d.APITokens {
+			if t.ID == id && t.RevokedAt == nil {
+				revokedAt := time.Now().UTC()
+				t.RevokedAt = &revokedAt
+				return nil
+			}
+		}
+
+		return persistency.NotFound("active api token with ID %s does not exist", id)
+	})
+}
+
+// seesUser reports whether a user is visible to the mock, without a tenant every user is visible since
+// logins look users up before their tenant is known
+func (d *DalMock) seesUser(user *persistency.User) bool {
+	return d.tenantID == "" || d.owns(user.TenantID)
+}
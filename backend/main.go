@@ -2,11 +2,17 @@ package main
 
 import (
 	"flower-management/internal/core/servicesinitializer"
+	persistency "flower-management/internal/persistency/contracts"
 
 	"github.com/spf13/cobra"
 )
 
 var envFilename string
+var purgeOlderThanDays int
+var username string
+var role string
+var tenantID string
+var tenantName string
 
 func main() {
 	cli := &cobra.Command{
@@ -14,7 +20,41 @@ func main() {
 			servicesinitializer.Execute(envFilename)
 		},
 	}
-	cli.Flags().StringVarP(&envFilename, "env-filename", "e", "", "environment variables")
+	cli.PersistentFlags().StringVarP(&envFilename, "env-filename", "e", "", "environment variables")
+
+	purge := &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently remove deleted flowers, products and events",
+		Run: func(cmd *cobra.Command, args []string) {
+			servicesinitializer.Purge(envFilename, purgeOlderThanDays)
+		},
+	}
+	purge.Flags().IntVarP(&purgeOlderThanDays, "days", "d", 30, "only remove rows deleted more than this many days ago")
+	cli.AddCommand(purge)
+
+	createUser := &cobra.Command{
+		Use:   "create-user",
+		Short: "Create a user account, the password is read from stdin",
+		Run: func(cmd *cobra.Command, args []string) {
+			servicesinitializer.CreateUser(envFilename, tenantID, username, role)
+		},
+	}
+	createUser.Flags().StringVarP(&username, "username", "u", "", "name of the new user")
+	createUser.MarkFlagRequired("username")
+	createUser.Flags().StringVarP(&role, "role", "r", "owner", "role of the new user: owner, planner, florist, buyer or read-only")
+	createUser.Flags().StringVarP(&tenantID, "tenant", "t", persistency.DefaultTenantID, "ID of the tenant the user belongs to")
+	cli.AddCommand(createUser)
+
+	createTenant := &cobra.Command{
+		Use:   "create-tenant",
+		Short: "Create a tenant for a branch of the shop",
+		Run: func(cmd *cobra.Command, args []string) {
+			servicesinitializer.CreateTenant(envFilename, tenantName)
+		},
+	}
+	createTenant.Flags().StringVarP(&tenantName, "name", "n", "", "name of the branch")
+	createTenant.MarkFlagRequired("name")
+	cli.AddCommand(createTenant)
 
 	err := cli.Execute()
 	if err != nil {